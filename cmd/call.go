@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"github.com/amannm/yxc/internal/app"
+	"github.com/spf13/cobra"
+)
+
+func newCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call <operationId> [key=value ...]",
+		Short: "Call any operation from the bundled API spec",
+		Long: "Call any operation from the bundled API spec by its operationId (or endpoint name, e.g. setVolume).\n" +
+			"Parameters are key=value pairs; zone defaults to --zone. Use --list to see every operation and\n" +
+			"`yxc call <operationId> --help` for its parameters.",
+		Example: "  yxc call setVolume zone=main volume=up step=2\n  yxc call getTunerPresetInfo band=fm",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			list, err := cmd.Flags().GetBool("list")
+			if err != nil {
+				return err
+			}
+			if list {
+				return app.New(opts).CallList()
+			}
+			return app.Run(cmd, opts, func(a *app.App) error {
+				return a.Call(cmd, args)
+			})
+		},
+	}
+
+	cmd.Flags().Bool("list", false, "List the operations in the bundled API spec")
+	cmd.Flags().String("data", "", "JSON request body, instead of key=value body fields")
+
+	defaultHelp := cmd.HelpFunc()
+	cmd.SetHelpFunc(func(c *cobra.Command, args []string) {
+		if ops := c.Flags().Args(); len(ops) > 0 {
+			if err := app.CallHelp(c.OutOrStdout(), ops[0]); err == nil {
+				return
+			}
+		}
+		defaultHelp(c, args)
+	})
+
+	return cmd
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/amannm/yxc/internal/app"
+	"github.com/spf13/cobra"
+)
+
+func newCompletionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "completion <bash|zsh|fish>",
+		Short: "Print a shell completion script",
+		Long: `Print a completion script for bash, zsh or fish.
+
+Inputs, sound programs, scenes, tuner bands, NetUSB presets and zones are
+completed from the configured device's cached getFeatures, getPresetInfo and
+getNameText, and from the bundled spec when the device cannot be reached.
+
+  bash:  source <(yxc completion bash)
+  zsh:   yxc completion zsh > "${fpath[1]}/_yxc"
+  fish:  yxc completion fish > ~/.config/fish/completions/yxc.fish`,
+		ValidArgs: []string{"bash", "zsh", "fish"},
+		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			switch args[0] {
+			case "bash":
+				return rootCmd.GenBashCompletionV2(out, true)
+			case "zsh":
+				return rootCmd.GenZshCompletion(out)
+			case "fish":
+				return rootCmd.GenFishCompletion(out, true)
+			}
+			return fmt.Errorf("unsupported shell %q", args[0])
+		},
+	}
+
+	return cmd
+}
+
+// completeFrom completes from the candidates app.Complete gives for what.
+// PersistentPreRunE does not run for completions, so the profile is applied
+// here; retries and request logging are turned off to keep the shell
+// responsive and quiet.
+func completeFrom(what string) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		o := opts
+		if app.ApplyProfile(&o, cmd.Flags().Changed) != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		o.Retries, o.DryRun, o.Record, o.Verbose = 0, "", "", 0
+		if o.Timeout <= 0 || o.Timeout > time.Second {
+			o.Timeout = time.Second
+		}
+		var out []cobra.Completion
+		for _, c := range app.New(o).Complete(cmd.Context(), what) {
+			if strings.HasPrefix(c, toComplete) {
+				out = append(out, c)
+			}
+		}
+		return out, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeArg completes a command's single positional argument.
+func completeArg(what string) cobra.CompletionFunc {
+	complete := completeFrom(what)
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return complete(cmd, args, toComplete)
+	}
+}
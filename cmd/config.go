@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"github.com/amannm/yxc/internal/app"
+	"github.com/spf13/cobra"
+)
+
+func runConfig(prefix ...string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		return app.New(opts).Config(cmd, append(prefix, args...))
+	}
+}
+
+func newConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage named device profiles",
+	}
+
+	cmd.AddCommand(
+		newConfigListCmd(),
+		newConfigShowCmd(),
+		newConfigAddCmd(),
+		newConfigRemoveCmd(),
+		newConfigUseCmd(),
+		newConfigPathCmd(),
+	)
+
+	return cmd
+}
+
+func newConfigListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List device profiles",
+		Args:  cobra.NoArgs,
+		RunE:  runConfig("list"),
+	}
+
+	return cmd
+}
+
+func newConfigShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [name]",
+		Short: "Show a device profile (default: the selected or default device)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  runConfig("show"),
+	}
+
+	return cmd
+}
+
+func newConfigAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "Add or replace a device profile from --host/--base-url/--auth/--header/--timeout/--retries/--zone",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runConfig("add"),
+	}
+
+	cmd.Flags().Bool("default", false, "Make this the default device")
+
+	return cmd
+}
+
+func newConfigRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove <name>",
+		Short: "Remove a device profile",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runConfig("remove"),
+	}
+
+	return cmd
+}
+
+func newConfigUseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "use <name>",
+		Short: "Set the default device",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runConfig("use"),
+	}
+
+	return cmd
+}
+
+func newConfigPathCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "path",
+		Short: "Print the config file path",
+		Args:  cobra.NoArgs,
+		RunE:  runConfig("path"),
+	}
+
+	return cmd
+}
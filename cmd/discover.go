@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"time"
+
 	"github.com/amannm/yxc/internal/app"
 	"github.com/spf13/cobra"
 )
@@ -10,9 +12,12 @@ func newDiscoverCmd() *cobra.Command {
 		Use:   "discover",
 		Short: "Discover Yamaha devices on the local network",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return app.New(opts).Discover()
+			return app.New(opts).Discover(cmd)
 		},
 	}
 
+	cmd.Flags().String("protocol", "all", "Discovery protocol (all|mdns|ssdp)")
+	cmd.Flags().Duration("wait", 3*time.Second, "How long to listen for responses")
+
 	return cmd
 }
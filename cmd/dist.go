@@ -7,7 +7,9 @@ import (
 
 func runDist(prefix ...string) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		return app.New(opts).Dist(cmd, append(prefix, args...))
+		return app.Run(cmd, opts, func(a *app.App) error {
+			return a.Dist(cmd, append(prefix, args...))
+		})
 	}
 }
 
@@ -18,23 +20,12 @@ func newDistCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
-		newDistInfoCmd(),
 		newDistServerCmd(),
 		newDistClientCmd(),
-		newDistStartCmd(),
-		newDistStopCmd(),
 		newDistGroupNameCmd(),
 	)
 
-	return cmd
-}
-
-func newDistInfoCmd() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "info",
-		Short: "Get distribution info",
-		RunE:  runDist("info"),
-	}
+	addGenerated(cmd, "dist")
 
 	return cmd
 }
@@ -65,29 +56,6 @@ func newDistClientCmd() *cobra.Command {
 	return cmd
 }
 
-func newDistStartCmd() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "start",
-		Short: "Start distribution",
-		RunE:  runDist("start"),
-	}
-
-	cmd.Flags().Int("num", 0, "Distribution number")
-	_ = cmd.MarkFlagRequired("num")
-
-	return cmd
-}
-
-func newDistStopCmd() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "stop",
-		Short: "Stop distribution",
-		RunE:  runDist("stop"),
-	}
-
-	return cmd
-}
-
 func newDistGroupNameCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "group-name",
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/amannm/yxc/internal/app"
+	"github.com/spf13/cobra"
+)
+
+func runEvents(prefix ...string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		return app.New(opts).Events(cmd, append(prefix, args...))
+	}
+}
+
+func newEventsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "UDP event notifications",
+	}
+
+	cmd.AddCommand(
+		newEventsWatchCmd(),
+	)
+
+	return cmd
+}
+
+func newEventsWatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Register for and stream device events",
+		RunE:  runEvents("watch"),
+	}
+
+	addEventFlags(cmd)
+
+	return cmd
+}
+
+func addEventFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("port", 0, "Local UDP port to receive events on (0 picks a free port)")
+	cmd.Flags().String("app-name", "", "X-AppName header value (MusicCast/<version>(<os>))")
+	cmd.Flags().Duration("renew", 5*time.Minute, "Re-register interval, must be under 10m")
+}
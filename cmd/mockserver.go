@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/amannm/yxc/internal/app"
+	"github.com/spf13/cobra"
+)
+
+func newMockServerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mock-server",
+		Short: "Serve an in-memory device for offline testing",
+		Long: "Serve the Extended Control API from an in-memory device. Setters change the\n" +
+			"state returned by getters, and clients that send X-AppName and X-AppPort\n" +
+			"receive UDP events, so any command can be tried with --base-url and no hardware.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.New(opts).MockServer(cmd)
+		},
+	}
+
+	cmd.Flags().String("listen", "127.0.0.1:8080", "Address to listen on")
+
+	return cmd
+}
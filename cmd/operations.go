@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/amannm/yxc/internal/app"
+	"github.com/spf13/cobra"
+)
+
+// operationFlag ties a flag of a generated command, or its positional
+// argument when arg is set, to the parameter or body field it sets. enum
+// lists the values the spec allows, if it limits them.
+type operationFlag struct {
+	name  string
+	field string
+	arg   bool
+	enum  []string
+}
+
+// runOperation sends op with the positional argument and the flags the user
+// set, after checking them against their enums unless --no-validate is set.
+func runOperation(op string, flags ...operationFlag) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var pairs []string
+		for _, f := range flags {
+			var v, what string
+			if f.arg {
+				v, what = args[0], f.field
+			} else {
+				fl := cmd.Flags().Lookup(f.name)
+				if fl == nil || !fl.Changed {
+					continue
+				}
+				v, what = fl.Value.String(), "--"+f.name
+			}
+			if len(f.enum) > 0 && !opts.NoValidate && !slices.ContainsFunc(f.enum, func(e string) bool { return strings.EqualFold(e, v) }) {
+				return fmt.Errorf("%s: %s must be one of %s", cmd.Name(), what, strings.Join(f.enum, "|"))
+			}
+			pairs = append(pairs, f.field+"="+v)
+		}
+		var data string
+		if fl := cmd.Flags().Lookup("data"); fl != nil {
+			data = fl.Value.String()
+		}
+		return app.Run(cmd, opts, func(a *app.App) error {
+			return a.CallOperation(cmd.Context(), op, pairs, data)
+		})
+	}
+}
+
+// addGenerated adds the generated commands of group to cmd. A hand-written
+// command with the same name takes precedence.
+func addGenerated(cmd *cobra.Command, group string) {
+	taken := map[string]bool{}
+	for _, c := range cmd.Commands() {
+		taken[c.Name()] = true
+	}
+	for _, c := range generatedCommands(group) {
+		if !taken[c.Name()] {
+			cmd.AddCommand(c)
+		}
+	}
+}
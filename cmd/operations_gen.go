@@ -0,0 +1,1967 @@
+// Code generated by specgen from reference/yamaha-extended-control-api.yaml; DO NOT EDIT.
+
+package cmd
+
+import "github.com/spf13/cobra"
+
+// generatedCommands returns the spec-derived commands for a command group.
+func generatedCommands(group string) []*cobra.Command {
+	switch group {
+	case "system":
+		return []*cobra.Command{
+			newSystemConnectBluetoothDeviceCmd(),
+			newSystemDisconnectBluetoothDeviceCmd(),
+			newSystemBluetoothDeviceListCmd(),
+			newSystemBluetoothInfoCmd(),
+			newSystemDeviceInfoCmd(),
+			newSystemFeaturesCmd(),
+			newSystemFuncStatusCmd(),
+			newSystemLocationCmd(),
+			newSystemNameCmd(),
+			newSystemNetworkStandbyCmd(),
+			newSystemNetworkStatusCmd(),
+			newSystemRemoteInfoCmd(),
+			newSystemIrCmd(),
+			newSystemAirplayPinCmd(),
+			newSystemAutoPlayCmd(),
+			newSystemAutoPowerStandbyCmd(),
+			newSystemBluetoothStandbyCmd(),
+			newSystemBluetoothTxSettingCmd(),
+			newSystemDimmerCmd(),
+			newSystemIrSensorCmd(),
+			newSystemNetworkNameCmd(),
+			newSystemPartyModeCmd(),
+			newSystemSpeakerACmd(),
+			newSystemSpeakerBCmd(),
+			newSystemSpeakerPatternCmd(),
+			newSystemWiredLanCmd(),
+			newSystemWirelessDirectCmd(),
+			newSystemWirelessLanCmd(),
+			newSystemZonebVolumeSyncCmd(),
+			newSystemUpdateBluetoothDeviceListCmd(),
+		}
+	case "zone":
+		return []*cobra.Command{
+			newZoneCursorCmd(),
+			newZoneMenuCmd(),
+			newZoneSoundProgramsCmd(),
+			newZoneSignalCmd(),
+			newZonePrepareInputCmd(),
+			newZoneActualVolumeCmd(),
+			newZoneOsdCmd(),
+			newZoneLinkDelayCmd(),
+			newZoneLinkQualityCmd(),
+			newZoneLinkControlCmd(),
+			newZoneSurroundDecoderCmd(),
+			newZoneSurround3dCmd(),
+			newZoneBalanceCmd(),
+			newZoneBassExtensionCmd(),
+			newZoneClearVoiceCmd(),
+			newZoneDialogueLevelCmd(),
+			newZoneDialogueLiftCmd(),
+			newZoneDirectCmd(),
+			newZoneEnhancerCmd(),
+			newZoneEqCmd(),
+			newZoneMuteCmd(),
+			newZonePowerCmd(),
+			newZonePureDirectCmd(),
+			newZoneSleepCmd(),
+			newZoneSubwooferVolumeCmd(),
+			newZoneToneCmd(),
+		}
+	case "tuner":
+		return []*cobra.Command{
+			newTunerClearCmd(),
+			newTunerPlayInfoCmd(),
+			newTunerPresetInfoCmd(),
+			newTunerMoveCmd(),
+			newTunerRecallCmd(),
+			newTunerDabServiceCmd(),
+			newTunerFreqCmd(),
+			newTunerStoreCmd(),
+			newTunerSwitchCmd(),
+		}
+	case "netusb":
+		return []*cobra.Command{
+			newNetusbPresetCmd(),
+			newNetusbRecentCmd(),
+			newNetusbAccountStatusCmd(),
+			newNetusbListCmd(),
+			newNetusbPlayDescriptionCmd(),
+			newNetusbPlayInfoCmd(),
+			newNetusbPresetInfoCmd(),
+			newNetusbSettingsCmd(),
+			newNetusbServiceInfoCmd(),
+			newNetusbManageListCmd(),
+			newNetusbManagePlayCmd(),
+			newNetusbListControlCmd(),
+			newNetusbListSortOptionCmd(),
+			newNetusbSeekCmd(),
+			newNetusbPlaybackCmd(),
+			newNetusbQualityCmd(),
+			newNetusbRepeatCmd(),
+			newNetusbShuffleCmd(),
+			newNetusbRepeatToggleCmd(),
+			newNetusbShuffleToggleCmd(),
+		}
+	case "cd":
+		return []*cobra.Command{
+			newCdPlayInfoCmd(),
+			newCdDirectCmd(),
+			newCdPlaybackCmd(),
+			newCdRepeatCmd(),
+			newCdShuffleCmd(),
+			newCdRepeatToggleCmd(),
+			newCdShuffleToggleCmd(),
+			newCdTrayCmd(),
+		}
+	case "clock":
+		return []*cobra.Command{
+			newClockSettingsCmd(),
+			newClockAutoSyncCmd(),
+			newClockFormatCmd(),
+			newClockDatetimeCmd(),
+		}
+	case "dist":
+		return []*cobra.Command{
+			newDistInfoCmd(),
+			newDistStartCmd(),
+			newDistStopCmd(),
+		}
+	}
+	return nil
+}
+
+func newSystemConnectBluetoothDeviceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "connect-bluetooth-device",
+		Short:       "Connect Bluetooth device",
+		Long:        "Connects to a Bluetooth sink device.\n\nOperation: connectBluetoothDevice",
+		Annotations: map[string]string{"operation": "connectBluetoothDevice"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("connectBluetoothDevice",
+			operationFlag{name: "address", field: "address"}),
+	}
+
+	cmd.Flags().String("address", "", "Bluetooth device address (12-digit hex)")
+	_ = cmd.MarkFlagRequired("address")
+
+	return cmd
+}
+
+func newSystemDisconnectBluetoothDeviceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "disconnect-bluetooth-device",
+		Short:       "Disconnect Bluetooth device",
+		Long:        "Disconnects the currently connected Bluetooth sink device.\n\nOperation: disconnectBluetoothDevice",
+		Annotations: map[string]string{"operation": "disconnectBluetoothDevice"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("disconnectBluetoothDevice"),
+	}
+
+	return cmd
+}
+
+func newSystemBluetoothDeviceListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "bluetooth-device-list",
+		Short:       "Get Bluetooth device list",
+		Long:        "Retrieves list of discovered Bluetooth sink devices.\n\nOperation: getBluetoothDeviceList",
+		Annotations: map[string]string{"operation": "getBluetoothDeviceList"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getBluetoothDeviceList"),
+	}
+
+	return cmd
+}
+
+func newSystemBluetoothInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "bluetooth-info",
+		Short:       "Get Bluetooth information",
+		Long:        "Retrieves Bluetooth configuration and connected device information.\n\nOperation: getBluetoothInfo",
+		Annotations: map[string]string{"operation": "getBluetoothInfo"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getBluetoothInfo"),
+	}
+
+	return cmd
+}
+
+func newSystemDeviceInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "device-info",
+		Short:       "Get device information",
+		Long:        "Retrieves basic information about the device including model name, firmware versions, and capabilities.\n\nOperation: getDeviceInfo",
+		Annotations: map[string]string{"operation": "getDeviceInfo"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getDeviceInfo"),
+	}
+
+	return cmd
+}
+
+func newSystemFeaturesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "features",
+		Short:       "Get device features",
+		Long:        "Retrieves comprehensive feature information including available inputs, zones, functions, and parameter ranges.\n\nOperation: getFeatures",
+		Annotations: map[string]string{"operation": "getFeatures"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getFeatures"),
+	}
+
+	return cmd
+}
+
+func newSystemFuncStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "func-status",
+		Short:       "Get function status",
+		Long:        "Retrieves overall system function status including speaker settings, HDMI outputs, etc.\n\nOperation: getFuncStatus",
+		Annotations: map[string]string{"operation": "getFuncStatus"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getFuncStatus"),
+	}
+
+	return cmd
+}
+
+func newSystemLocationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "location",
+		Short:       "Get location info",
+		Long:        "Retrieves MusicCast network location information.\n\nOperation: getLocationInfo",
+		Annotations: map[string]string{"operation": "getLocationInfo"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getLocationInfo"),
+	}
+
+	return cmd
+}
+
+func newSystemNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "name",
+		Short: "Get name text, set name text",
+	}
+
+	cmd.AddCommand(
+		newSystemNameGetCmd(),
+		newSystemNameSetCmd(),
+	)
+
+	return cmd
+}
+
+func newSystemNameGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "get",
+		Short:       "Get name text",
+		Long:        "Retrieves custom name text for inputs and sound programs.\n\nOperation: getNameText",
+		Annotations: map[string]string{"operation": "getNameText"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("getNameText",
+			operationFlag{name: "id", field: "id"}),
+	}
+
+	cmd.Flags().String("id", "", "ID of input or sound program")
+	_ = cmd.MarkFlagRequired("id")
+
+	return cmd
+}
+
+func newSystemNameSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "set",
+		Short:       "Set name text",
+		Long:        "Sets custom name text for inputs.\n\nOperation: setNameText",
+		Annotations: map[string]string{"operation": "setNameText"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setNameText",
+			operationFlag{name: "id", field: "id"},
+			operationFlag{name: "text", field: "text"}),
+	}
+
+	cmd.Flags().String("id", "", "Input or sound program ID")
+	_ = cmd.MarkFlagRequired("id")
+	cmd.Flags().String("text", "", "New text (max 64 chars)")
+	_ = cmd.MarkFlagRequired("text")
+
+	return cmd
+}
+
+func newSystemNetworkStandbyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "network-standby",
+		Short: "Get network standby setting, set network standby",
+	}
+
+	cmd.AddCommand(
+		newSystemNetworkStandbyGetCmd(),
+		newSystemNetworkStandbySetCmd(),
+	)
+
+	return cmd
+}
+
+func newSystemNetworkStandbyGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "get",
+		Short:       "Get network standby setting",
+		Long:        "Retrieves the network standby configuration.\n\nOperation: getNetworkStandby",
+		Annotations: map[string]string{"operation": "getNetworkStandby"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getNetworkStandby"),
+	}
+
+	return cmd
+}
+
+func newSystemNetworkStandbySetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "set",
+		Short:       "Set network standby",
+		Long:        "Configures network standby mode.\n\nOperation: setNetworkStandby",
+		Annotations: map[string]string{"operation": "setNetworkStandby"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setNetworkStandby",
+			operationFlag{name: "standby", field: "standby", enum: []string{"off", "on", "auto"}}),
+	}
+
+	cmd.Flags().String("standby", "", "Network standby setting: off|on|auto")
+	_ = cmd.MarkFlagRequired("standby")
+
+	return cmd
+}
+
+func newSystemNetworkStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "network-status",
+		Short:       "Get network status",
+		Long:        "Retrieves network configuration and status including IP settings, wireless info, and MAC addresses.\n\nOperation: getNetworkStatus",
+		Annotations: map[string]string{"operation": "getNetworkStatus"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getNetworkStatus"),
+	}
+
+	return cmd
+}
+
+func newSystemRemoteInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "remote-info",
+		Short:       "Get remote monitor info",
+		Long:        "Retrieves remote monitor information. Available when remote_info is in the system func_list of getFeatures.\n\nOperation: getRemoteInfo",
+		Annotations: map[string]string{"operation": "getRemoteInfo"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getRemoteInfo"),
+	}
+
+	return cmd
+}
+
+func newSystemIrCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "ir",
+		Short:       "Send IR code",
+		Long:        "Sends an IR remote control code.\n\nOperation: sendIrCode",
+		Annotations: map[string]string{"operation": "sendIrCode"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("sendIrCode",
+			operationFlag{name: "code", field: "code"}),
+	}
+
+	cmd.Flags().String("code", "", "IR code in hex format")
+	_ = cmd.MarkFlagRequired("code")
+
+	return cmd
+}
+
+func newSystemAirplayPinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "airplay-pin",
+		Short:       "Set AirPlay PIN",
+		Long:        "Sets the AirPlay PIN code.\n\nOperation: setAirPlayPin",
+		Annotations: map[string]string{"operation": "setAirPlayPin"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setAirPlayPin",
+			operationFlag{name: "pin", field: "pin"}),
+	}
+
+	cmd.Flags().String("pin", "", "AirPlay PIN code")
+	_ = cmd.MarkFlagRequired("pin")
+
+	return cmd
+}
+
+func newSystemAutoPlayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "auto-play",
+		Short:       "Set auto play",
+		Long:        "Configures auto play behavior.\n\nOperation: setAutoPlay",
+		Annotations: map[string]string{"operation": "setAutoPlay"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setAutoPlay",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable auto play")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newSystemAutoPowerStandbyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "auto-power-standby",
+		Short:       "Set auto power standby",
+		Long:        "Enables or disables auto power standby.\n\nOperation: setAutoPowerStandby",
+		Annotations: map[string]string{"operation": "setAutoPowerStandby"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setAutoPowerStandby",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable auto power standby")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newSystemBluetoothStandbyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "bluetooth-standby",
+		Short:       "Set Bluetooth standby",
+		Long:        "Enables or disables Bluetooth standby mode.\n\nOperation: setBluetoothStandby",
+		Annotations: map[string]string{"operation": "setBluetoothStandby"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setBluetoothStandby",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable Bluetooth standby")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newSystemBluetoothTxSettingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "bluetooth-tx-setting",
+		Short:       "Set Bluetooth transmission",
+		Long:        "Enables or disables Bluetooth audio transmission.\n\nOperation: setBluetoothTxSetting",
+		Annotations: map[string]string{"operation": "setBluetoothTxSetting"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setBluetoothTxSetting",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable Bluetooth transmission")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newSystemDimmerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "dimmer",
+		Short:       "Set dimmer",
+		Long:        "Sets the display dimmer level.\n\nOperation: setDimmer",
+		Annotations: map[string]string{"operation": "setDimmer"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setDimmer",
+			operationFlag{name: "value", field: "value"}),
+	}
+
+	cmd.Flags().Int("value", 0, "Dimmer value (-1 for auto, 0+ for manual)")
+	_ = cmd.MarkFlagRequired("value")
+
+	return cmd
+}
+
+func newSystemIrSensorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "ir-sensor",
+		Short:       "Set IR sensor",
+		Long:        "Enables or disables the IR remote sensor.\n\nOperation: setIrSensor",
+		Annotations: map[string]string{"operation": "setIrSensor"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setIrSensor",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable the IR sensor")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newSystemNetworkNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "network-name",
+		Short:       "Set network name",
+		Long:        "Sets the device's network name (friendly name).\n\nOperation: setNetworkName",
+		Annotations: map[string]string{"operation": "setNetworkName"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setNetworkName",
+			operationFlag{name: "name", field: "name"}),
+	}
+
+	cmd.Flags().String("name", "", "Network name (UTF-8, max 32 bytes)")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func newSystemPartyModeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "party-mode",
+		Short:       "Set party mode",
+		Long:        "Enables or disables party mode.\n\nOperation: setPartyMode",
+		Annotations: map[string]string{"operation": "setPartyMode"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setPartyMode",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable party mode")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newSystemSpeakerACmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "speaker-a",
+		Short:       "Set speaker A",
+		Long:        "Enables or disables Speaker A output.\n\nOperation: setSpeakerA",
+		Annotations: map[string]string{"operation": "setSpeakerA"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setSpeakerA",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable Speaker A output")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newSystemSpeakerBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "speaker-b",
+		Short:       "Set speaker B",
+		Long:        "Enables or disables Speaker B output.\n\nOperation: setSpeakerB",
+		Annotations: map[string]string{"operation": "setSpeakerB"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setSpeakerB",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable Speaker B output")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newSystemSpeakerPatternCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "speaker-pattern",
+		Short:       "Set speaker pattern",
+		Long:        "Sets the speaker configuration pattern.\n\nOperation: setSpeakerPattern",
+		Annotations: map[string]string{"operation": "setSpeakerPattern"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setSpeakerPattern",
+			operationFlag{name: "num", field: "num"}),
+	}
+
+	cmd.Flags().Int("num", 0, "Speaker configuration pattern number (1..)")
+	_ = cmd.MarkFlagRequired("num")
+
+	return cmd
+}
+
+func newSystemWiredLanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "wired-lan",
+		Short:       "Configure wired LAN",
+		Long:        "Configures wired network settings. Switches network connection to wired mode.\n\nOperation: setWiredLan",
+		Annotations: map[string]string{"operation": "setWiredLan"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setWiredLan",
+			operationFlag{name: "default-gateway", field: "default_gateway"},
+			operationFlag{name: "dhcp", field: "dhcp"},
+			operationFlag{name: "dns-server-1", field: "dns_server_1"},
+			operationFlag{name: "dns-server-2", field: "dns_server_2"},
+			operationFlag{name: "ip-address", field: "ip_address"},
+			operationFlag{name: "subnet-mask", field: "subnet_mask"}),
+	}
+
+	cmd.Flags().String("default-gateway", "", "Default gateway")
+	cmd.Flags().Bool("dhcp", false, "Obtain the addresses by DHCP")
+	cmd.Flags().String("dns-server-1", "", "DNS server 1")
+	cmd.Flags().String("dns-server-2", "", "DNS server 2")
+	cmd.Flags().String("ip-address", "", "IP address")
+	cmd.Flags().String("subnet-mask", "", "Subnet mask")
+	cmd.Flags().String("data", "", "JSON request body, instead of the field flags")
+
+	return cmd
+}
+
+func newSystemWirelessDirectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "wireless-direct",
+		Short:       "Configure wireless direct",
+		Long:        "Configures wireless direct connection settings.\n\nOperation: setWirelessDirect",
+		Annotations: map[string]string{"operation": "setWirelessDirect"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setWirelessDirect",
+			operationFlag{name: "key", field: "key"},
+			operationFlag{name: "type", field: "type", enum: []string{"none", "wep", "wpa2-psk(aes)", "mixed_mode"}}),
+	}
+
+	cmd.Flags().String("key", "", "Security key (max 64 chars)")
+	cmd.Flags().String("type", "", "Security type: none|wep|wpa2-psk(aes)|mixed_mode")
+	cmd.Flags().String("data", "", "JSON request body, instead of the field flags")
+
+	return cmd
+}
+
+func newSystemWirelessLanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "wireless-lan",
+		Short:       "Configure wireless LAN",
+		Long:        "Configures wireless network settings. Switches network connection to Wi-Fi mode.\n\nOperation: setWirelessLan",
+		Annotations: map[string]string{"operation": "setWirelessLan"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setWirelessLan",
+			operationFlag{name: "default-gateway", field: "default_gateway"},
+			operationFlag{name: "dhcp", field: "dhcp"},
+			operationFlag{name: "dns-server-1", field: "dns_server_1"},
+			operationFlag{name: "dns-server-2", field: "dns_server_2"},
+			operationFlag{name: "ip-address", field: "ip_address"},
+			operationFlag{name: "key", field: "key"},
+			operationFlag{name: "ssid", field: "ssid"},
+			operationFlag{name: "subnet-mask", field: "subnet_mask"},
+			operationFlag{name: "type", field: "type", enum: []string{"none", "wep", "wpa2-psk(aes)", "mixed_mode"}}),
+	}
+
+	cmd.Flags().String("default-gateway", "", "Default gateway")
+	cmd.Flags().Bool("dhcp", false, "Obtain the addresses by DHCP")
+	cmd.Flags().String("dns-server-1", "", "DNS server 1")
+	cmd.Flags().String("dns-server-2", "", "DNS server 2")
+	cmd.Flags().String("ip-address", "", "IP address")
+	cmd.Flags().String("key", "", "Security key (max 64 chars)")
+	cmd.Flags().String("ssid", "", "Network name (max 32 chars)")
+	cmd.Flags().String("subnet-mask", "", "Subnet mask")
+	cmd.Flags().String("type", "", "Security type: none|wep|wpa2-psk(aes)|mixed_mode")
+	cmd.Flags().String("data", "", "JSON request body, instead of the field flags")
+
+	return cmd
+}
+
+func newSystemZonebVolumeSyncCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "zoneb-volume-sync",
+		Short:       "Set Zone B volume sync",
+		Long:        "Enables or disables Zone B volume synchronization with Zone A.\n\nOperation: setZoneBVolumeSync",
+		Annotations: map[string]string{"operation": "setZoneBVolumeSync"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZoneBVolumeSync",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable Zone B volume synchronization")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newSystemUpdateBluetoothDeviceListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "update-bluetooth-device-list",
+		Short:       "Update Bluetooth device list",
+		Long:        "Starts scanning for Bluetooth sink devices.\n\nOperation: updateBluetoothDeviceList",
+		Annotations: map[string]string{"operation": "updateBluetoothDeviceList"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("updateBluetoothDeviceList"),
+	}
+
+	return cmd
+}
+
+func newZoneCursorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "cursor <up|down|left|right|select|return>",
+		Short:       "Control cursor",
+		Long:        "Sends cursor navigation commands.\n\nOperation: controlCursor",
+		Annotations: map[string]string{"operation": "controlCursor"},
+		Args:        cobra.ExactArgs(1),
+		ValidArgs:   []string{"up", "down", "left", "right", "select", "return"},
+		RunE: runOperation("controlCursor",
+			operationFlag{field: "cursor", arg: true, enum: []string{"up", "down", "left", "right", "select", "return"}}),
+	}
+
+	return cmd
+}
+
+func newZoneMenuCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "menu <on_screen|top_menu|menu|option|display|help|home|mode|red|green|yellow|blue>",
+		Short:       "Execute menu",
+		Long:        "Executes menu commands.\n\nOperation: executeMenu",
+		Annotations: map[string]string{"operation": "executeMenu"},
+		Args:        cobra.ExactArgs(1),
+		ValidArgs:   []string{"on_screen", "top_menu", "menu", "option", "display", "help", "home", "mode", "red", "green", "yellow", "blue"},
+		RunE: runOperation("executeMenu",
+			operationFlag{field: "menu", arg: true, enum: []string{"on_screen", "top_menu", "menu", "option", "display", "help", "home", "mode", "red", "green", "yellow", "blue"}}),
+	}
+
+	return cmd
+}
+
+func newZoneSoundProgramsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "sound-programs",
+		Short:       "Get sound program list",
+		Long:        "Retrieves list of available sound programs for the zone.\n\nOperation: getSoundProgramList",
+		Annotations: map[string]string{"operation": "getSoundProgramList"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getSoundProgramList"),
+	}
+
+	return cmd
+}
+
+func newZoneSignalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "signal",
+		Short:       "Get signal info",
+		Long:        "Retrieves audio signal information for the zone.\n\nOperation: getZoneSignalInfo",
+		Annotations: map[string]string{"operation": "getZoneSignalInfo"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getZoneSignalInfo"),
+	}
+
+	return cmd
+}
+
+func newZonePrepareInputCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "prepare-input",
+		Short:       "Prepare input change",
+		Long:        "Prepares the device for an input change operation.\n\nOperation: prepareInputChange",
+		Annotations: map[string]string{"operation": "prepareInputChange"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("prepareInputChange",
+			operationFlag{name: "input", field: "input", enum: []string{"cd", "tuner", "multi_ch", "phono", "hdmi1", "hdmi2", "hdmi3", "hdmi4", "hdmi5", "hdmi6", "hdmi7", "hdmi8", "hdmi", "av1", "av2", "av3", "av4", "av5", "av6", "av7", "v_aux", "aux1", "aux2", "aux", "audio1", "audio2", "audio3", "audio4", "audio_cd", "audio", "optical1", "optical2", "optical", "coaxial1", "coaxial2", "coaxial", "digital1", "digital2", "digital", "line1", "line2", "line3", "line_cd", "analog", "tv", "bd_dvd", "usb_dac", "usb", "bluetooth", "server", "net_radio", "rhapsody", "napster", "pandora", "siriusxm", "spotify", "juke", "airplay", "radiko", "qobuz", "mc_link", "main_sync", "none"}}),
+	}
+
+	cmd.Flags().String("input", "", "Input ID to prepare")
+	_ = cmd.MarkFlagRequired("input")
+
+	return cmd
+}
+
+func newZoneActualVolumeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "actual-volume",
+		Short:       "Set actual volume mode",
+		Long:        "Sets the volume display mode.\n\nOperation: setActualVolume",
+		Annotations: map[string]string{"operation": "setActualVolume"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setActualVolume",
+			operationFlag{name: "mode", field: "mode", enum: []string{"db", "numeric"}},
+			operationFlag{name: "value", field: "value"}),
+	}
+
+	cmd.Flags().String("mode", "", "Volume mode: db|numeric")
+	_ = cmd.MarkFlagRequired("mode")
+	cmd.Flags().Float64("value", 0, "Volume in the chosen mode")
+
+	return cmd
+}
+
+func newZoneOsdCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "osd",
+		Short:       "Set contents display",
+		Long:        "Enables or disables the on-screen display.\n\nOperation: setContentsDisplay",
+		Annotations: map[string]string{"operation": "setContentsDisplay"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setContentsDisplay",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable the contents display on screen")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newZoneLinkDelayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "link-delay",
+		Short:       "Set link audio delay",
+		Long:        "Sets the MusicCast Link audio delay mode.\n\nOperation: setLinkAudioDelay",
+		Annotations: map[string]string{"operation": "setLinkAudioDelay"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setLinkAudioDelay",
+			operationFlag{name: "delay", field: "delay", enum: []string{"lip_sync", "audio_sync", "audio_sync_on", "audio_sync_off", "balanced"}}),
+	}
+
+	cmd.Flags().String("delay", "", "Delay mode: lip_sync|audio_sync|audio_sync_on|audio_sync_off|balanced")
+	_ = cmd.MarkFlagRequired("delay")
+
+	return cmd
+}
+
+func newZoneLinkQualityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "link-quality",
+		Short:       "Set link audio quality",
+		Long:        "Sets the MusicCast Link audio quality.\n\nOperation: setLinkAudioQuality",
+		Annotations: map[string]string{"operation": "setLinkAudioQuality"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setLinkAudioQuality",
+			operationFlag{name: "quality", field: "quality", enum: []string{"compressed", "uncompressed"}}),
+	}
+
+	cmd.Flags().String("quality", "", "Audio quality: compressed|uncompressed")
+	_ = cmd.MarkFlagRequired("quality")
+
+	return cmd
+}
+
+func newZoneLinkControlCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "link-control",
+		Short:       "Set link control",
+		Long:        "Sets the MusicCast Link control mode.\n\nOperation: setLinkControl",
+		Annotations: map[string]string{"operation": "setLinkControl"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setLinkControl",
+			operationFlag{name: "control", field: "control", enum: []string{"standard", "stability", "speed"}}),
+	}
+
+	cmd.Flags().String("control", "", "Link control mode: standard|stability|speed")
+	_ = cmd.MarkFlagRequired("control")
+
+	return cmd
+}
+
+func newZoneSurroundDecoderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "surround-decoder",
+		Short:       "Set surround decoder type",
+		Long:        "Sets the surround decoder mode.\n\nOperation: setSurroundDecoderType",
+		Annotations: map[string]string{"operation": "setSurroundDecoderType"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setSurroundDecoderType",
+			operationFlag{name: "type", field: "type", enum: []string{"toggle", "auto", "dolby_pl", "dolby_pl2x_movie", "dolby_pl2x_music", "dolby_pl2x_game", "dolby_surround", "dts_neural_x", "dts_neo6_cinema", "dts_neo6_music"}}),
+	}
+
+	cmd.Flags().String("type", "", "Decoder type: toggle|auto|dolby_pl|dolby_pl2x_movie|dolby_pl2x_music|dolby_pl2x_game|dolby_surround|dts_neural_x|dts_neo6_cinema|dts_neo6_music")
+	_ = cmd.MarkFlagRequired("type")
+
+	return cmd
+}
+
+func newZoneSurround3dCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "surround-3d",
+		Short:       "Set 3D surround",
+		Long:        "Enables or disables 3D surround processing.\n\nOperation: setZone3dSurround",
+		Annotations: map[string]string{"operation": "setZone3dSurround"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZone3dSurround",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable 3D surround")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newZoneBalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "balance",
+		Short:       "Set balance",
+		Long:        "Sets left/right speaker balance.\n\nOperation: setZoneBalance",
+		Annotations: map[string]string{"operation": "setZoneBalance"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZoneBalance",
+			operationFlag{name: "value", field: "value"}),
+	}
+
+	cmd.Flags().Int("value", 0, "Balance value (negative=left, positive=right)")
+	_ = cmd.MarkFlagRequired("value")
+
+	return cmd
+}
+
+func newZoneBassExtensionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "bass-extension",
+		Short:       "Set bass extension",
+		Long:        "Enables or disables bass extension.\n\nOperation: setZoneBassExtension",
+		Annotations: map[string]string{"operation": "setZoneBassExtension"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZoneBassExtension",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable bass extension")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newZoneClearVoiceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "clear-voice",
+		Short:       "Set clear voice",
+		Long:        "Enables or disables clear voice enhancement.\n\nOperation: setZoneClearVoice",
+		Annotations: map[string]string{"operation": "setZoneClearVoice"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZoneClearVoice",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable Clear Voice")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newZoneDialogueLevelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "dialogue-level",
+		Short:       "Set dialogue level",
+		Long:        "Sets the dialogue/vocal enhancement level.\n\nOperation: setZoneDialogueLevel",
+		Annotations: map[string]string{"operation": "setZoneDialogueLevel"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZoneDialogueLevel",
+			operationFlag{name: "value", field: "value"}),
+	}
+
+	cmd.Flags().Int("value", 0, "Dialogue level")
+	_ = cmd.MarkFlagRequired("value")
+
+	return cmd
+}
+
+func newZoneDialogueLiftCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "dialogue-lift",
+		Short:       "Set dialogue lift",
+		Long:        "Sets the dialogue lift height.\n\nOperation: setZoneDialogueLift",
+		Annotations: map[string]string{"operation": "setZoneDialogueLift"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZoneDialogueLift",
+			operationFlag{name: "value", field: "value"}),
+	}
+
+	cmd.Flags().Int("value", 0, "Dialogue lift height")
+	_ = cmd.MarkFlagRequired("value")
+
+	return cmd
+}
+
+func newZoneDirectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "direct",
+		Short:       "Set direct mode",
+		Long:        "Enables or disables direct playback mode.\n\nOperation: setZoneDirect",
+		Annotations: map[string]string{"operation": "setZoneDirect"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZoneDirect",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable direct mode")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newZoneEnhancerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "enhancer",
+		Short:       "Set enhancer",
+		Long:        "Enables or disables the compressed music enhancer.\n\nOperation: setZoneEnhancer",
+		Annotations: map[string]string{"operation": "setZoneEnhancer"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZoneEnhancer",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable the Compressed Music Enhancer")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newZoneEqCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "eq",
+		Short:       "Set equalizer",
+		Long:        "Sets equalizer settings (low, mid, high).\n\nOperation: setZoneEqualizer",
+		Annotations: map[string]string{"operation": "setZoneEqualizer"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZoneEqualizer",
+			operationFlag{name: "mode", field: "mode", enum: []string{"manual", "auto", "bypass"}},
+			operationFlag{name: "low", field: "low"},
+			operationFlag{name: "mid", field: "mid"},
+			operationFlag{name: "high", field: "high"}),
+	}
+
+	cmd.Flags().String("mode", "", "EQ mode: manual|auto|bypass")
+	cmd.Flags().Int("low", 0, "Low band level")
+	cmd.Flags().Int("mid", 0, "Mid band level")
+	cmd.Flags().Int("high", 0, "High band level")
+
+	return cmd
+}
+
+func newZoneMuteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "mute",
+		Short:       "Set zone mute",
+		Long:        "Sets mute state for the specified zone.\n\nOperation: setZoneMute",
+		Annotations: map[string]string{"operation": "setZoneMute"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZoneMute",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable mute")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newZonePowerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "power <on|standby|toggle>",
+		Short:       "Set zone power",
+		Long:        "Sets power state of the specified zone.\n\nOperation: setZonePower",
+		Annotations: map[string]string{"operation": "setZonePower"},
+		Args:        cobra.ExactArgs(1),
+		ValidArgs:   []string{"on", "standby", "toggle"},
+		RunE: runOperation("setZonePower",
+			operationFlag{field: "power", arg: true, enum: []string{"on", "standby", "toggle"}}),
+	}
+
+	return cmd
+}
+
+func newZonePureDirectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "pure-direct",
+		Short:       "Set pure direct mode",
+		Long:        "Enables or disables pure direct playback mode.\n\nOperation: setZonePureDirect",
+		Annotations: map[string]string{"operation": "setZonePureDirect"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZonePureDirect",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable pure direct mode")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newZoneSleepCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "sleep <0|30|60|90|120>",
+		Short:       "Set sleep timer",
+		Long:        "Sets sleep timer for the specified zone.\n\nOperation: setZoneSleep",
+		Annotations: map[string]string{"operation": "setZoneSleep"},
+		Args:        cobra.ExactArgs(1),
+		ValidArgs:   []string{"0", "30", "60", "90", "120"},
+		RunE: runOperation("setZoneSleep",
+			operationFlag{field: "sleep", arg: true, enum: []string{"0", "30", "60", "90", "120"}}),
+	}
+
+	return cmd
+}
+
+func newZoneSubwooferVolumeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "subwoofer-volume",
+		Short:       "Set subwoofer volume",
+		Long:        "Sets the subwoofer volume level.\n\nOperation: setZoneSubwooferVolume",
+		Annotations: map[string]string{"operation": "setZoneSubwooferVolume"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZoneSubwooferVolume",
+			operationFlag{name: "volume", field: "volume"}),
+	}
+
+	cmd.Flags().Int("volume", 0, "Subwoofer volume")
+	_ = cmd.MarkFlagRequired("volume")
+
+	return cmd
+}
+
+func newZoneToneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "tone",
+		Short:       "Set tone control",
+		Long:        "Sets bass and treble levels.\n\nOperation: setZoneToneControl",
+		Annotations: map[string]string{"operation": "setZoneToneControl"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setZoneToneControl",
+			operationFlag{name: "mode", field: "mode", enum: []string{"manual", "auto", "bypass"}},
+			operationFlag{name: "bass", field: "bass"},
+			operationFlag{name: "treble", field: "treble"}),
+	}
+
+	cmd.Flags().String("mode", "", "Tone mode: manual|auto|bypass")
+	cmd.Flags().Int("bass", 0, "Bass level")
+	cmd.Flags().Int("treble", 0, "Treble level")
+
+	return cmd
+}
+
+func newTunerClearCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "clear",
+		Short:       "Clear tuner preset",
+		Long:        "Clears a tuner preset.\n\nOperation: clearTunerPreset",
+		Annotations: map[string]string{"operation": "clearTunerPreset"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("clearTunerPreset",
+			operationFlag{name: "band", field: "band", enum: []string{"common", "am", "fm", "dab"}},
+			operationFlag{name: "num", field: "num"}),
+	}
+
+	cmd.Flags().String("band", "", "Preset band: common|am|fm|dab")
+	_ = cmd.MarkFlagRequired("band")
+	cmd.Flags().Int("num", 0, "Preset number")
+	_ = cmd.MarkFlagRequired("num")
+
+	return cmd
+}
+
+func newTunerPlayInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "play-info",
+		Short:       "Get tuner play info",
+		Long:        "Retrieves current tuner playback information.\n\nOperation: getTunerPlayInfo",
+		Annotations: map[string]string{"operation": "getTunerPlayInfo"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getTunerPlayInfo"),
+	}
+
+	return cmd
+}
+
+func newTunerPresetInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "preset-info",
+		Short:       "Get tuner preset info",
+		Long:        "Retrieves tuner preset information.\n\nOperation: getTunerPresetInfo",
+		Annotations: map[string]string{"operation": "getTunerPresetInfo"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("getTunerPresetInfo",
+			operationFlag{name: "band", field: "band", enum: []string{"common", "am", "fm", "dab"}}),
+	}
+
+	cmd.Flags().String("band", "", "Band type (common or separate by band): common|am|fm|dab")
+	_ = cmd.MarkFlagRequired("band")
+
+	return cmd
+}
+
+func newTunerMoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "move",
+		Short:       "Move tuner preset",
+		Long:        "Moves a tuner preset to a new location.\n\nOperation: moveTunerPreset",
+		Annotations: map[string]string{"operation": "moveTunerPreset"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("moveTunerPreset",
+			operationFlag{name: "band", field: "band", enum: []string{"common", "am", "fm", "dab"}},
+			operationFlag{name: "from", field: "from"},
+			operationFlag{name: "to", field: "to"}),
+	}
+
+	cmd.Flags().String("band", "", "Preset band: common|am|fm|dab")
+	_ = cmd.MarkFlagRequired("band")
+	cmd.Flags().Int("from", 0, "Source preset number")
+	_ = cmd.MarkFlagRequired("from")
+	cmd.Flags().Int("to", 0, "Destination preset number")
+	_ = cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func newTunerRecallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "recall",
+		Short:       "Recall tuner preset",
+		Long:        "Recalls a tuner preset.\n\nOperation: recallTunerPreset",
+		Annotations: map[string]string{"operation": "recallTunerPreset"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("recallTunerPreset",
+			operationFlag{name: "band", field: "band", enum: []string{"common", "am", "fm", "dab"}},
+			operationFlag{name: "num", field: "num"}),
+	}
+
+	cmd.Flags().String("band", "", "Preset band: common|am|fm|dab")
+	_ = cmd.MarkFlagRequired("band")
+	cmd.Flags().Int("num", 0, "Preset number")
+	_ = cmd.MarkFlagRequired("num")
+
+	return cmd
+}
+
+func newTunerDabServiceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "dab-service",
+		Short:       "Set DAB service",
+		Long:        "Selects a DAB service by direction.\n\nOperation: setDabService",
+		Annotations: map[string]string{"operation": "setDabService"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setDabService",
+			operationFlag{name: "dir", field: "dir", enum: []string{"next", "previous"}}),
+	}
+
+	cmd.Flags().String("dir", "", "Direction: next|previous")
+	_ = cmd.MarkFlagRequired("dir")
+
+	return cmd
+}
+
+func newTunerFreqCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "freq",
+		Short:       "Set tuner frequency",
+		Long:        "Sets the tuner frequency or initiates auto scan.\n\nOperation: setTunerFreq",
+		Annotations: map[string]string{"operation": "setTunerFreq"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setTunerFreq",
+			operationFlag{name: "band", field: "band", enum: []string{"am", "fm"}},
+			operationFlag{name: "tuning", field: "tuning", enum: []string{"tp_up", "tp_down", "direct", "auto_up", "auto_down", "cancel"}},
+			operationFlag{name: "num", field: "num"}),
+	}
+
+	cmd.Flags().String("band", "", "Tuner band: am|fm")
+	_ = cmd.MarkFlagRequired("band")
+	cmd.Flags().String("tuning", "", "Tuning mode: tp_up|tp_down|direct|auto_up|auto_down|cancel")
+	_ = cmd.MarkFlagRequired("tuning")
+	cmd.Flags().Int("num", 0, "Frequency in kHz (for direct tuning)")
+
+	return cmd
+}
+
+func newTunerStoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "store",
+		Short:       "Store tuner preset",
+		Long:        "Stores current station to a preset.\n\nOperation: storeTunerPreset",
+		Annotations: map[string]string{"operation": "storeTunerPreset"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("storeTunerPreset",
+			operationFlag{name: "num", field: "num"}),
+	}
+
+	cmd.Flags().Int("num", 0, "Preset number")
+	_ = cmd.MarkFlagRequired("num")
+
+	return cmd
+}
+
+func newTunerSwitchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "switch",
+		Short:       "Switch tuner preset",
+		Long:        "Switches to next or previous tuner preset.\n\nOperation: switchTunerPreset",
+		Annotations: map[string]string{"operation": "switchTunerPreset"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("switchTunerPreset",
+			operationFlag{name: "dir", field: "dir", enum: []string{"next", "previous"}}),
+	}
+
+	cmd.Flags().String("dir", "", "Direction: next|previous")
+	_ = cmd.MarkFlagRequired("dir")
+
+	return cmd
+}
+
+func newNetusbPresetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preset",
+		Short: "Clear Net/USB preset, move Net/USB preset, store Net/USB preset",
+	}
+
+	cmd.AddCommand(
+		newNetusbPresetClearCmd(),
+		newNetusbPresetMoveCmd(),
+		newNetusbPresetStoreCmd(),
+	)
+
+	return cmd
+}
+
+func newNetusbPresetClearCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "clear",
+		Short:       "Clear Net/USB preset",
+		Long:        "Clears a Net/USB preset.\n\nOperation: clearNetUsbPreset",
+		Annotations: map[string]string{"operation": "clearNetUsbPreset"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("clearNetUsbPreset",
+			operationFlag{name: "num", field: "num"}),
+	}
+
+	cmd.Flags().Int("num", 0, "Preset number")
+	_ = cmd.MarkFlagRequired("num")
+
+	return cmd
+}
+
+func newNetusbPresetMoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "move",
+		Short:       "Move Net/USB preset",
+		Long:        "Moves a preset to a new location.\n\nOperation: moveNetUsbPreset",
+		Annotations: map[string]string{"operation": "moveNetUsbPreset"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("moveNetUsbPreset",
+			operationFlag{name: "from", field: "from"},
+			operationFlag{name: "to", field: "to"}),
+	}
+
+	cmd.Flags().Int("from", 0, "Source preset number")
+	_ = cmd.MarkFlagRequired("from")
+	cmd.Flags().Int("to", 0, "Destination preset number")
+	_ = cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func newNetusbPresetStoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "store",
+		Short:       "Store Net/USB preset",
+		Long:        "Stores current content to a preset.\n\nOperation: storeNetUsbPreset",
+		Annotations: map[string]string{"operation": "storeNetUsbPreset"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("storeNetUsbPreset",
+			operationFlag{name: "num", field: "num"}),
+	}
+
+	cmd.Flags().Int("num", 0, "Preset number")
+	_ = cmd.MarkFlagRequired("num")
+
+	return cmd
+}
+
+func newNetusbRecentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recent",
+		Short: "Clear recent info, get recent info, recall recent item",
+	}
+
+	cmd.AddCommand(
+		newNetusbRecentClearCmd(),
+		newNetusbRecentGetCmd(),
+		newNetusbRecentRecallCmd(),
+	)
+
+	return cmd
+}
+
+func newNetusbRecentClearCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "clear",
+		Short:       "Clear recent info",
+		Long:        "Clears the recently played history.\n\nOperation: clearRecentInfo",
+		Annotations: map[string]string{"operation": "clearRecentInfo"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("clearRecentInfo"),
+	}
+
+	return cmd
+}
+
+func newNetusbRecentGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "get",
+		Short:       "Get recent info",
+		Long:        "Retrieves recently played content information.\n\nOperation: getRecentInfo",
+		Annotations: map[string]string{"operation": "getRecentInfo"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getRecentInfo"),
+	}
+
+	return cmd
+}
+
+func newNetusbRecentRecallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "recall",
+		Short:       "Recall recent item",
+		Long:        "Plays a recently played item.\n\nOperation: recallRecentItem",
+		Annotations: map[string]string{"operation": "recallRecentItem"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("recallRecentItem",
+			operationFlag{name: "num", field: "num"}),
+	}
+
+	cmd.Flags().Int("num", 0, "Item number in the recent list")
+	_ = cmd.MarkFlagRequired("num")
+
+	return cmd
+}
+
+func newNetusbAccountStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "account-status",
+		Short:       "Get account status",
+		Long:        "Retrieves streaming service account status.\n\nOperation: getAccountStatus",
+		Annotations: map[string]string{"operation": "getAccountStatus"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getAccountStatus"),
+	}
+
+	return cmd
+}
+
+func newNetusbListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "list",
+		Short:       "Get list info",
+		Long:        "Retrieves browsable list information.\n\nOperation: getNetUsbListInfo",
+		Annotations: map[string]string{"operation": "getNetUsbListInfo"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("getNetUsbListInfo",
+			operationFlag{name: "input", field: "input"},
+			operationFlag{name: "index", field: "index"},
+			operationFlag{name: "size", field: "size"},
+			operationFlag{name: "lang", field: "lang"}),
+	}
+
+	cmd.Flags().String("input", "", "Input source ID")
+	_ = cmd.MarkFlagRequired("input")
+	cmd.Flags().Int("index", 0, "Starting index")
+	cmd.Flags().Int("size", 8, "Number of items to retrieve (1..8)")
+	cmd.Flags().String("lang", "", "Language code")
+
+	return cmd
+}
+
+func newNetusbPlayDescriptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "play-description",
+		Short:       "Get play description",
+		Long:        "Retrieves detail information about the playing track.\n\nOperation: getNetUsbPlayDescription",
+		Annotations: map[string]string{"operation": "getNetUsbPlayDescription"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("getNetUsbPlayDescription",
+			operationFlag{name: "type", field: "type", enum: []string{"why_this_song"}},
+			operationFlag{name: "netusb-timeout", field: "timeout"}),
+	}
+
+	cmd.Flags().String("type", "", "Kind of description: why_this_song")
+	_ = cmd.MarkFlagRequired("type")
+	cmd.Flags().Int("netusb-timeout", 0, "Timeout for the operation in milliseconds (0 for the maximum) (0..60000)")
+	_ = cmd.MarkFlagRequired("netusb-timeout")
+
+	return cmd
+}
+
+func newNetusbPlayInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "play-info",
+		Short:       "Get Net/USB play info",
+		Long:        "Retrieves current Net/USB playback information.\n\nOperation: getNetUsbPlayInfo",
+		Annotations: map[string]string{"operation": "getNetUsbPlayInfo"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getNetUsbPlayInfo"),
+	}
+
+	return cmd
+}
+
+func newNetusbPresetInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "preset-info",
+		Short:       "Get Net/USB preset info",
+		Long:        "Retrieves Net/USB preset information.\n\nOperation: getNetUsbPresetInfo",
+		Annotations: map[string]string{"operation": "getNetUsbPresetInfo"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getNetUsbPresetInfo"),
+	}
+
+	return cmd
+}
+
+func newNetusbSettingsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "settings",
+		Short:       "Get Net/USB settings",
+		Long:        "Retrieves streaming service settings.\n\nOperation: getNetUsbSettings",
+		Annotations: map[string]string{"operation": "getNetUsbSettings"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getNetUsbSettings"),
+	}
+
+	return cmd
+}
+
+func newNetusbServiceInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "service-info",
+		Short:       "Get service info",
+		Long:        "Retrieves streaming service-specific information.\n\nOperation: getServiceInfo",
+		Annotations: map[string]string{"operation": "getServiceInfo"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("getServiceInfo",
+			operationFlag{name: "input", field: "input"},
+			operationFlag{name: "type", field: "type"}),
+	}
+
+	cmd.Flags().String("input", "", "Input ID (e.g. pandora)")
+	_ = cmd.MarkFlagRequired("input")
+	cmd.Flags().String("type", "", "Service info type")
+
+	return cmd
+}
+
+func newNetusbManageListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "manage-list",
+		Short:       "Manage list item",
+		Long:        "Performs service-specific processing for a list item, such as adding or removing bookmarks, tracks or playlists.\n\nOperation: manageNetUsbList",
+		Annotations: map[string]string{"operation": "manageNetUsbList"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("manageNetUsbList",
+			operationFlag{name: "list-id", field: "list_id", enum: []string{"main", "auto_complete", "search_artist", "search_track"}},
+			operationFlag{name: "type", field: "type", enum: []string{"add_bookmark", "add_track", "add_album", "add_artist", "add_channel", "add_playlist", "remove_bookmark", "remove_track", "remove_album", "remove_artist", "remove_channel", "remove_playlist", "remove_from_playlist", "end_auto_complete"}},
+			operationFlag{name: "index", field: "index"},
+			operationFlag{name: "bank", field: "bank"},
+			operationFlag{name: "netusb-timeout", field: "timeout"}),
+	}
+
+	cmd.Flags().String("list-id", "main", "List ID: main|auto_complete|search_artist|search_track")
+	cmd.Flags().String("type", "", "Processing to perform (availability depends on the service): add_bookmark|add_track|add_album|add_artist|add_channel|add_playlist|remove_bookmark|remove_track|remove_album|remove_artist|remove_channel|remove_playlist|remove_from_playlist|end_auto_complete")
+	_ = cmd.MarkFlagRequired("type")
+	cmd.Flags().Int("index", 0, "Index of the item from the beginning of the list (omit for end_auto_complete) (0..64999)")
+	cmd.Flags().Int("bank", 0, "Reserved")
+	cmd.Flags().Int("netusb-timeout", 0, "Timeout for the operation in milliseconds (0 for the maximum) (0..60000)")
+	_ = cmd.MarkFlagRequired("netusb-timeout")
+
+	return cmd
+}
+
+func newNetusbManagePlayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "manage-play",
+		Short:       "Manage playing track",
+		Long:        "Performs service-specific processing for the playing track, such as bookmarking it or rating it.\n\nOperation: manageNetUsbPlay",
+		Annotations: map[string]string{"operation": "manageNetUsbPlay"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("manageNetUsbPlay",
+			operationFlag{name: "type", field: "type", enum: []string{"add_bookmark", "add_track", "add_album", "add_channel_track", "add_channel_artist", "add_playlist", "add_to_playlist", "thumbs_up", "thumbs_down", "mark_tired"}},
+			operationFlag{name: "bank", field: "bank"},
+			operationFlag{name: "netusb-timeout", field: "timeout"}),
+	}
+
+	cmd.Flags().String("type", "", "Processing to perform (availability depends on the service): add_bookmark|add_track|add_album|add_channel_track|add_channel_artist|add_playlist|add_to_playlist|thumbs_up|thumbs_down|mark_tired")
+	_ = cmd.MarkFlagRequired("type")
+	cmd.Flags().Int("bank", 0, "Reserved")
+	cmd.Flags().Int("netusb-timeout", 0, "Timeout for the operation in milliseconds (0 for the maximum) (0..60000)")
+	_ = cmd.MarkFlagRequired("netusb-timeout")
+
+	return cmd
+}
+
+func newNetusbListControlCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "list-control",
+		Short:       "Set list control",
+		Long:        "Controls list navigation and selection.\n\nOperation: setNetUsbListControl",
+		Annotations: map[string]string{"operation": "setNetUsbListControl"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setNetUsbListControl",
+			operationFlag{name: "list-id", field: "list_id", enum: []string{"main", "auto_complete", "search_artist", "search_track"}},
+			operationFlag{name: "type", field: "type", enum: []string{"select", "play", "return"}},
+			operationFlag{name: "index", field: "index"}),
+	}
+
+	cmd.Flags().String("list-id", "main", "List ID: main|auto_complete|search_artist|search_track")
+	cmd.Flags().String("type", "", "List operation: select|play|return")
+	_ = cmd.MarkFlagRequired("type")
+	cmd.Flags().Int("index", 0, "Item index (required for select/play)")
+
+	return cmd
+}
+
+func newNetusbListSortOptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "list-sort-option",
+		Short:       "Set list sort option",
+		Long:        "Sets how lists of the input are sorted. Retrieve the list again with getListInfo afterwards.\n\nOperation: setNetUsbListSortOption",
+		Annotations: map[string]string{"operation": "setNetUsbListSortOption"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setNetUsbListSortOption",
+			operationFlag{name: "input", field: "input"},
+			operationFlag{name: "type", field: "type"}),
+	}
+
+	cmd.Flags().String("input", "", "Input ID (e.g. pandora)")
+	_ = cmd.MarkFlagRequired("input")
+	cmd.Flags().String("type", "", "Sort method, from sort_option_list in getFeatures (date or alphabet when absent)")
+	_ = cmd.MarkFlagRequired("type")
+
+	return cmd
+}
+
+func newNetusbSeekCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "seek",
+		Short:       "Set play position",
+		Long:        "Sets the playback position (seek).\n\nOperation: setNetUsbPlayPosition",
+		Annotations: map[string]string{"operation": "setNetUsbPlayPosition"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setNetUsbPlayPosition",
+			operationFlag{name: "position", field: "position"}),
+	}
+
+	cmd.Flags().Int("position", 0, "Position in seconds")
+	_ = cmd.MarkFlagRequired("position")
+
+	return cmd
+}
+
+func newNetusbPlaybackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "playback <play|stop|pause|play_pause|previous|next|fast_reverse_start|fast_reverse_end|fast_forward_start|fast_forward_end>",
+		Short:       "Set Net/USB playback",
+		Long:        "Controls playback state.\n\nOperation: setNetUsbPlayback",
+		Annotations: map[string]string{"operation": "setNetUsbPlayback"},
+		Args:        cobra.ExactArgs(1),
+		ValidArgs:   []string{"play", "stop", "pause", "play_pause", "previous", "next", "fast_reverse_start", "fast_reverse_end", "fast_forward_start", "fast_forward_end"},
+		RunE: runOperation("setNetUsbPlayback",
+			operationFlag{field: "playback", arg: true, enum: []string{"play", "stop", "pause", "play_pause", "previous", "next", "fast_reverse_start", "fast_reverse_end", "fast_forward_start", "fast_forward_end"}}),
+	}
+
+	return cmd
+}
+
+func newNetusbQualityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "quality",
+		Short:       "Set quality",
+		Long:        "Sets streaming quality settings.\n\nOperation: setNetUsbQuality",
+		Annotations: map[string]string{"operation": "setNetUsbQuality"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setNetUsbQuality",
+			operationFlag{name: "input", field: "input"},
+			operationFlag{name: "value", field: "value"}),
+	}
+
+	cmd.Flags().String("input", "", "Input ID")
+	_ = cmd.MarkFlagRequired("input")
+	cmd.Flags().String("value", "", "Quality value")
+	_ = cmd.MarkFlagRequired("value")
+
+	return cmd
+}
+
+func newNetusbRepeatCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "repeat <off|one|all>",
+		Short:       "Set repeat mode",
+		Long:        "Sets the repeat mode.\n\nOperation: setNetUsbRepeat",
+		Annotations: map[string]string{"operation": "setNetUsbRepeat"},
+		Args:        cobra.ExactArgs(1),
+		ValidArgs:   []string{"off", "one", "all"},
+		RunE: runOperation("setNetUsbRepeat",
+			operationFlag{field: "mode", arg: true, enum: []string{"off", "one", "all"}}),
+	}
+
+	return cmd
+}
+
+func newNetusbShuffleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "shuffle <off|on|songs|albums>",
+		Short:       "Set shuffle mode",
+		Long:        "Sets the shuffle mode.\n\nOperation: setNetUsbShuffle",
+		Annotations: map[string]string{"operation": "setNetUsbShuffle"},
+		Args:        cobra.ExactArgs(1),
+		ValidArgs:   []string{"off", "on", "songs", "albums"},
+		RunE: runOperation("setNetUsbShuffle",
+			operationFlag{field: "mode", arg: true, enum: []string{"off", "on", "songs", "albums"}}),
+	}
+
+	return cmd
+}
+
+func newNetusbRepeatToggleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "repeat-toggle",
+		Short:       "Toggle repeat",
+		Long:        "Toggles through repeat modes.\n\nOperation: toggleNetUsbRepeat",
+		Annotations: map[string]string{"operation": "toggleNetUsbRepeat"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("toggleNetUsbRepeat"),
+	}
+
+	return cmd
+}
+
+func newNetusbShuffleToggleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "shuffle-toggle",
+		Short:       "Toggle shuffle",
+		Long:        "Toggles through shuffle modes.\n\nOperation: toggleNetUsbShuffle",
+		Annotations: map[string]string{"operation": "toggleNetUsbShuffle"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("toggleNetUsbShuffle"),
+	}
+
+	return cmd
+}
+
+func newCdPlayInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "play-info",
+		Short:       "Get CD play info",
+		Long:        "Retrieves current CD playback information.\n\nOperation: getCdPlayInfo",
+		Annotations: map[string]string{"operation": "getCdPlayInfo"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getCdPlayInfo"),
+	}
+
+	return cmd
+}
+
+func newCdDirectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "direct",
+		Short:       "Set CD direct",
+		Long:        "Enables or disables direct CD playback.\n\nOperation: setCdDirect",
+		Annotations: map[string]string{"operation": "setCdDirect"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setCdDirect",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable direct CD playback")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newCdPlaybackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "playback <play|stop|pause|previous|next|fast_reverse_start|fast_reverse_end|fast_forward_start|fast_forward_end|track_select>",
+		Short:       "Set CD playback",
+		Long:        "Controls CD playback.\n\nOperation: setCdPlayback",
+		Annotations: map[string]string{"operation": "setCdPlayback"},
+		Args:        cobra.ExactArgs(1),
+		ValidArgs:   []string{"play", "stop", "pause", "previous", "next", "fast_reverse_start", "fast_reverse_end", "fast_forward_start", "fast_forward_end", "track_select"},
+		RunE: runOperation("setCdPlayback",
+			operationFlag{field: "playback", arg: true, enum: []string{"play", "stop", "pause", "previous", "next", "fast_reverse_start", "fast_reverse_end", "fast_forward_start", "fast_forward_end", "track_select"}},
+			operationFlag{name: "num", field: "num"}),
+	}
+
+	cmd.Flags().Int("num", 0, "Track number (for track_select)")
+
+	return cmd
+}
+
+func newCdRepeatCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "repeat <off|one|all|folder|a-b>",
+		Short:       "Set CD repeat",
+		Long:        "Sets CD repeat mode.\n\nOperation: setCdRepeat",
+		Annotations: map[string]string{"operation": "setCdRepeat"},
+		Args:        cobra.ExactArgs(1),
+		ValidArgs:   []string{"off", "one", "all", "folder", "a-b"},
+		RunE: runOperation("setCdRepeat",
+			operationFlag{field: "mode", arg: true, enum: []string{"off", "one", "all", "folder", "a-b"}}),
+	}
+
+	return cmd
+}
+
+func newCdShuffleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "shuffle <off|on|folder|program>",
+		Short:       "Set CD shuffle",
+		Long:        "Sets CD shuffle mode.\n\nOperation: setCdShuffle",
+		Annotations: map[string]string{"operation": "setCdShuffle"},
+		Args:        cobra.ExactArgs(1),
+		ValidArgs:   []string{"off", "on", "folder", "program"},
+		RunE: runOperation("setCdShuffle",
+			operationFlag{field: "mode", arg: true, enum: []string{"off", "on", "folder", "program"}}),
+	}
+
+	return cmd
+}
+
+func newCdRepeatToggleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "repeat-toggle",
+		Short:       "Toggle CD repeat",
+		Long:        "Toggles through CD repeat modes.\n\nOperation: toggleCdRepeat",
+		Annotations: map[string]string{"operation": "toggleCdRepeat"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("toggleCdRepeat"),
+	}
+
+	return cmd
+}
+
+func newCdShuffleToggleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "shuffle-toggle",
+		Short:       "Toggle CD shuffle",
+		Long:        "Toggles through CD shuffle modes.\n\nOperation: toggleCdShuffle",
+		Annotations: map[string]string{"operation": "toggleCdShuffle"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("toggleCdShuffle"),
+	}
+
+	return cmd
+}
+
+func newCdTrayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tray",
+		Short: "Toggle CD tray",
+	}
+
+	cmd.AddCommand(
+		newCdTrayToggleCmd(),
+	)
+
+	return cmd
+}
+
+func newCdTrayToggleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "toggle",
+		Short:       "Toggle CD tray",
+		Long:        "Opens or closes the CD tray.\n\nOperation: toggleCdTray",
+		Annotations: map[string]string{"operation": "toggleCdTray"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("toggleCdTray"),
+	}
+
+	return cmd
+}
+
+func newClockSettingsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "settings",
+		Short:       "Get clock settings",
+		Long:        "Retrieves clock and alarm settings.\n\nOperation: getClockSettings",
+		Annotations: map[string]string{"operation": "getClockSettings"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getClockSettings"),
+	}
+
+	return cmd
+}
+
+func newClockAutoSyncCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "auto-sync",
+		Short:       "Set clock auto sync",
+		Long:        "Enables or disables automatic clock synchronization.\n\nOperation: setClockAutoSync",
+		Annotations: map[string]string{"operation": "setClockAutoSync"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setClockAutoSync",
+			operationFlag{name: "enable", field: "enable"}),
+	}
+
+	cmd.Flags().Bool("enable", false, "Enable/disable automatic clock synchronization")
+	_ = cmd.MarkFlagRequired("enable")
+
+	return cmd
+}
+
+func newClockFormatCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "format <12h|24h>",
+		Short:       "Set clock format",
+		Long:        "Sets the clock display format.\n\nOperation: setClockFormat",
+		Annotations: map[string]string{"operation": "setClockFormat"},
+		Args:        cobra.ExactArgs(1),
+		ValidArgs:   []string{"12h", "24h"},
+		RunE: runOperation("setClockFormat",
+			operationFlag{field: "format", arg: true, enum: []string{"12h", "24h"}}),
+	}
+
+	return cmd
+}
+
+func newClockDatetimeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "datetime",
+		Short:       "Set date and time",
+		Long:        "Manually sets the date and time.\n\nOperation: setDateAndTime",
+		Annotations: map[string]string{"operation": "setDateAndTime"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("setDateAndTime",
+			operationFlag{name: "date-time", field: "date_time"}),
+	}
+
+	cmd.Flags().String("date-time", "", "Date/time in YYMMDDhhmmss format")
+	_ = cmd.MarkFlagRequired("date-time")
+
+	return cmd
+}
+
+func newDistInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "info",
+		Short:       "Get distribution info",
+		Long:        "Retrieves MusicCast Link distribution information.\n\nOperation: getDistributionInfo",
+		Annotations: map[string]string{"operation": "getDistributionInfo"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("getDistributionInfo"),
+	}
+
+	return cmd
+}
+
+func newDistStartCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "start",
+		Short:       "Start distribution",
+		Long:        "Starts Link audio distribution.\n\nOperation: startDistribution",
+		Annotations: map[string]string{"operation": "startDistribution"},
+		Args:        cobra.NoArgs,
+		RunE: runOperation("startDistribution",
+			operationFlag{name: "num", field: "num"}),
+	}
+
+	cmd.Flags().Int("num", 0, "Distribution number on current MusicCast Network")
+	_ = cmd.MarkFlagRequired("num")
+
+	return cmd
+}
+
+func newDistStopCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "stop",
+		Short:       "Stop distribution",
+		Long:        "Stops Link audio distribution.\n\nOperation: stopDistribution",
+		Annotations: map[string]string{"operation": "stopDistribution"},
+		Args:        cobra.NoArgs,
+		RunE:        runOperation("stopDistribution"),
+	}
+
+	return cmd
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"github.com/amannm/yxc/internal/app"
+	"github.com/spf13/cobra"
+)
+
+func runPresets(prefix ...string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		return app.New(opts).Presets(cmd, append(prefix, args...))
+	}
+}
+
+func newPresetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "presets",
+		Short: "Named volume presets, scoped per device and zone",
+		Long: `Named volume presets, scoped per device and zone and kept in presets.yaml
+next to the config file. Use a preset with "yxc zone volume <name>".
+
+Presets kept as "name = volume" lines (the old settings.txt) are not read
+directly; bring them in once with "yxc presets import settings.txt".`,
+	}
+
+	cmd.AddCommand(
+		newPresetsListCmd(),
+		newPresetsSetCmd(),
+		newPresetsDeleteCmd(),
+		newPresetsImportCmd(),
+	)
+
+	return cmd
+}
+
+func newPresetsListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List volume presets for the current device and zone",
+		Args:  cobra.NoArgs,
+		RunE:  runPresets("list"),
+	}
+
+	cmd.Flags().Bool("all-zones", false, "List presets for every device and zone")
+
+	return cmd
+}
+
+func newPresetsSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <name> <volume>",
+		Short: "Create or update a volume preset",
+		Args:  cobra.ExactArgs(2),
+		RunE:  runPresets("set"),
+	}
+
+	return cmd
+}
+
+func newPresetsDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a volume preset",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runPresets("delete"),
+	}
+
+	return cmd
+}
+
+func newPresetsImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import \"name = volume\" lines (e.g. settings.txt) for the current device and zone",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runPresets("import"),
+	}
+
+	return cmd
+}
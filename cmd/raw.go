@@ -11,7 +11,9 @@ func newRawCmd() *cobra.Command {
 		Short: "Call an arbitrary endpoint by path",
 		Args:  cobra.MinimumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return app.New(opts).Raw(cmd, args)
+			return app.Run(cmd, opts, func(a *app.App) error {
+				return a.Raw(cmd, args)
+			})
 		},
 	}
 
@@ -1,7 +1,11 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/amannm/yxc/internal/app"
@@ -11,36 +15,107 @@ import (
 var opts app.Options
 
 var rootCmd = &cobra.Command{
-	Use:          "yxc",
-	Short:        "CLI for Yamaha Extended Control API",
-	Long:         "Interact with Yamaha receivers that expose the Extended Control API over HTTP.",
-	SilenceUsage: true,
-	Version:      app.Version,
+	Use:           "yxc",
+	Short:         "CLI for Yamaha Extended Control API",
+	Long:          "Interact with Yamaha receivers that expose the Extended Control API over HTTP.\n\n" + app.ExitCodesHelp,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Version:       app.Version,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if (opts.All || len(opts.Devices) > 0) && !fanOutCommands[topLevel(cmd).Name()] {
+			return fmt.Errorf("%s does not support --devices or --all", cmd.CommandPath())
+		}
+		if err := app.ApplyProfile(&opts, cmd.Flags().Changed); err != nil {
+			return err
+		}
+		if err := app.CheckDryRun(opts.DryRun); err != nil {
+			return err
+		}
+		if err := app.CheckOutput(opts); err != nil {
+			return err
+		}
+		return app.OpenSession(opts)
+	},
+}
+
+// fanOutCommands are the command groups that can run against several
+// devices at once.
+var fanOutCommands = map[string]bool{
+	"system": true,
+	"zone":   true,
+	"tuner":  true,
+	"netusb": true,
+	"cd":     true,
+	"clock":  true,
+	"dist":   true,
+	"raw":    true,
+	"call":   true,
+}
+
+func topLevel(cmd *cobra.Command) *cobra.Command {
+	for cmd.HasParent() && cmd.Parent().HasParent() {
+		cmd = cmd.Parent()
+	}
+	return cmd
 }
 
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
+	// The first SIGINT or SIGTERM cancels the command's context so in-flight
+	// requests and retry waits stop cleanly; a second one kills the process.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		app.ReportError(os.Stderr, err, opts.Format)
+		os.Exit(app.ExitCode(err))
 	}
 }
 
 func init() {
+	rootCmd.PersistentFlags().StringVar(&opts.ConfigPath, "config", app.DefaultConfigPath(), "Config file with named device profiles")
+	rootCmd.PersistentFlags().StringVarP(&opts.Device, "device", "d", "", "Named device profile from the config file")
+	rootCmd.PersistentFlags().StringSliceVar(&opts.Devices, "devices", nil, "Run against several device profiles or hosts concurrently (a,b,c)")
+	rootCmd.PersistentFlags().BoolVar(&opts.All, "all", false, "Run against every configured device, or every discovered one if none are configured")
 	rootCmd.PersistentFlags().StringVarP(&opts.Host, "host", "H", "", "Receiver host/IP (e.g. 192.168.1.50)")
 	rootCmd.PersistentFlags().StringVar(&opts.BaseURL, "base-url", "", "Full base URL (default: http://<host>/YamahaExtendedControl)")
 	rootCmd.PersistentFlags().StringVar(&opts.APIPrefix, "api-prefix", "/v1", "API prefix")
 	rootCmd.PersistentFlags().StringVar(&opts.Zone, "zone", "main", "Default zone for zone commands: main|zone2|zone3|zone4")
 	rootCmd.PersistentFlags().DurationVar(&opts.Timeout, "timeout", 7*time.Second, "Request timeout (e.g. 2s, 500ms)")
-	rootCmd.PersistentFlags().IntVar(&opts.Retries, "retries", 3, "Retry count on transient network errors")
+	rootCmd.PersistentFlags().IntVar(&opts.Retries, "retries", 3, "Retry count for transient failures (network errors, HTTP 5xx, Initializing, Time Out)")
+	rootCmd.PersistentFlags().DurationVar(&opts.Retry.Delay, "retry-delay", 200*time.Millisecond, "Initial retry backoff, doubled per attempt with jitter")
+	rootCmd.PersistentFlags().DurationVar(&opts.Retry.MaxDelay, "retry-max-delay", 5*time.Second, "Upper bound on the retry backoff")
+	rootCmd.PersistentFlags().DurationVar(&opts.Retry.FirmwareWait, "firmware-wait", 0, "Keep retrying while the device reports Firmware Updating, up to this long (0: fail at once)")
+	rootCmd.PersistentFlags().BoolVar(&opts.Retry.RetryWrites, "retry-writes", false, "Also retry setters, not only getters")
+	rootCmd.PersistentFlags().DurationVar(&opts.Throttle.MinGap, "request-gap", 50*time.Millisecond, "Minimum time between requests to the same device")
+	rootCmd.PersistentFlags().IntVar(&opts.Throttle.MaxInFlight, "max-in-flight", 1, "Maximum concurrent requests to the same device")
+	rootCmd.PersistentFlags().StringVar(&opts.Throttle.LockDir, "lock-dir", app.DefaultLockDir(), "Directory for per-device lock files shared across yxc processes (empty: no cross-process lock)")
 	rootCmd.PersistentFlags().StringVar(&opts.Auth, "auth", "", "Basic auth (user:pass)")
+	rootCmd.PersistentFlags().StringVar(&opts.Proxy, "proxy", "", "HTTP proxy URL (default: from HTTP_PROXY/NO_PROXY)")
 	rootCmd.PersistentFlags().StringArrayVar(&opts.Headers, "header", nil, "Add an HTTP header (repeatable)")
-	rootCmd.PersistentFlags().BoolVar(&opts.DryRun, "dry-run", false, "Print the HTTP request that would be sent, do not send it")
-	rootCmd.PersistentFlags().StringVar(&opts.Format, "format", "pretty", "Output: json|pretty|yaml|table")
+	rootCmd.PersistentFlags().StringVar(&opts.DryRun, "dry-run", "", "Print the HTTP request instead of sending it: raw|curl|httpie|go (bare --dry-run: raw)")
+	rootCmd.PersistentFlags().Lookup("dry-run").NoOptDefVal = "raw"
+	rootCmd.PersistentFlags().StringVar(&opts.Record, "record", "", "Append every HTTP exchange to a JSONL session file")
+	rootCmd.PersistentFlags().StringVar(&opts.Replay, "replay", "", "Answer requests from a recorded JSONL session instead of the network")
+	rootCmd.PersistentFlags().BoolVar(&opts.NoValidate, "no-validate", false, "Send requests without checking them against the bundled API spec and the device's getFeatures")
+	rootCmd.PersistentFlags().DurationVar(&opts.FeaturesTTL, "features-ttl", 24*time.Hour, "How long to trust cached getFeatures results when checking what a model supports (0: fetch every time)")
+	rootCmd.PersistentFlags().StringVar(&opts.FeaturesDir, "features-dir", app.DefaultFeaturesDir(), "Directory for getFeatures results cached per device_id (empty: no cache)")
+	rootCmd.PersistentFlags().BoolVar(&opts.Strict, "strict", false, "Also check responses against the bundled API spec and fail on differences")
+	rootCmd.PersistentFlags().StringVar(&opts.Format, "format", "pretty", "Output: json|pretty|yaml|table|csv|ndjson|template=<Go template> (helpers: dB, duration, join, default, json)")
+	rootCmd.PersistentFlags().StringArrayVar(&opts.Fields, "field", nil, "Print only the value at a path such as actual_volume.value or zone[?id==main].volume (repeatable)")
+	rootCmd.PersistentFlags().StringSliceVar(&opts.Columns, "columns", nil, "Table and CSV columns to show, in order (dotted names, e.g. power,volume,actual_volume.value)")
+	rootCmd.PersistentFlags().StringVar(&opts.SortBy, "sort-by", "", "Sort table and CSV rows by a column (prefix with - for descending)")
 	rootCmd.PersistentFlags().CountVarP(&opts.Verbose, "verbose", "v", "Verbose logging (repeatable: -vv for more)")
 	rootCmd.PersistentFlags().BoolVarP(&opts.Quiet, "quiet", "q", false, "Only print command output (no status lines)")
 	rootCmd.PersistentFlags().BoolVar(&opts.NoColor, "no-color", false, "Disable ANSI colors")
 
 	rootCmd.AddCommand(
 		newDiscoverCmd(),
+		newEventsCmd(),
+		newStateCmd(),
+		newWatchCmd(),
 		newSystemCmd(),
 		newZoneCmd(),
 		newTunerCmd(),
@@ -48,7 +123,14 @@ func init() {
 		newCdCmd(),
 		newClockCmd(),
 		newDistCmd(),
+		newConfigCmd(),
+		newPresetsCmd(),
 		newRawCmd(),
+		newCallCmd(),
+		newMockServerCmd(),
 		newVersionCmd(),
+		newCompletionCmd(),
 	)
+	rootCmd.CompletionOptions.DisableDefaultCmd = true
+	_ = rootCmd.RegisterFlagCompletionFunc("zone", completeFrom("zone"))
 }
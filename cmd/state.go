@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"github.com/amannm/yxc/internal/app"
+	"github.com/spf13/cobra"
+)
+
+func newStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Dump the merged status of all zones and sources",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.New(opts).State(cmd)
+		},
+	}
+
+	cmd.Flags().Bool("follow", false, "Keep the snapshot current from UDP events and print it on every change")
+	addEventFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/amannm/yxc/internal/app"
+	"github.com/spf13/cobra"
+)
+
+func newWatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch [target...]",
+		Short: "Poll a getter and print fields as they change",
+		Long: "Poll a getter and print one diff record per changed field.\n\n" +
+			"Targets: " + app.WatchTargets() + " (default: zone status).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.New(opts).Watch(cmd, args)
+		},
+	}
+
+	cmd.Flags().Duration("interval", 2*time.Second, "Polling interval")
+
+	return cmd
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/amannm/yxc/pkg/yxc"
+	"github.com/amannm/yxc/pkg/yxc/mock"
+)
+
+// TestZoneVolumeNegative runs zone volume with negative levels, which pflag
+// would read as shorthand flags, before, after and without other flags.
+func TestZoneVolumeNegative(t *testing.T) {
+	d := mock.New()
+	defer d.Close()
+	srv := httptest.NewServer(d)
+	defer srv.Close()
+	stdout := os.Stdout
+	defer func() { os.Stdout = stdout }()
+	devnull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer devnull.Close()
+	os.Stdout = devnull
+
+	global := []string{
+		"--base-url", srv.URL + "/YamahaExtendedControl",
+		"--config", filepath.Join(t.TempDir(), "config.yaml"),
+		"--features-dir", "", "--lock-dir", "", "--request-gap", "0",
+	}
+	ctx := context.Background()
+	for _, tc := range []struct {
+		args []string
+		zone string
+		want int
+	}{
+		{args: []string{"zone", "volume", "-25.5dB"}, zone: "main", want: 110},
+		{args: []string{"zone", "volume", "--zone", "zone2", "-40dB"}, zone: "zone2", want: 81},
+		{args: []string{"zone", "volume", "-30dB", "--zone", "main"}, zone: "main", want: 101},
+		{args: []string{"zone", "volume", "--zone", "main", "--", "-20dB"}, zone: "main", want: 121},
+		{args: []string{"zone", "volume", "--zone", "main", "up", "--step", "2"}, zone: "main", want: 123},
+	} {
+		rootCmd.SetArgs(append(append([]string{}, global...), tc.args...))
+		if err := rootCmd.ExecuteContext(ctx); err != nil {
+			t.Fatalf("%q: %v", tc.args, err)
+		}
+		status, err := yxc.New(yxc.Config{BaseURL: srv.URL + "/YamahaExtendedControl"}).Zone(tc.zone).GetStatus(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.Volume != tc.want {
+			t.Errorf("%q: %s volume %d, want %d", tc.args, tc.zone, status.Volume, tc.want)
+		}
+	}
+
+	for _, args := range [][]string{
+		{"zone", "volume", "-25.5dB", "--bogus"},
+		{"zone", "volume", "-25.5dB", "40"},
+		{"zone", "volume"},
+	} {
+		rootCmd.SetArgs(append(append([]string{}, global...), args...))
+		if err := rootCmd.ExecuteContext(ctx); err == nil {
+			t.Errorf("%q: no error", args)
+		}
+	}
+}
@@ -1,27 +1,61 @@
 package app
 
-import "time"
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/amannm/yxc/pkg/yxc"
+)
 
 type Options struct {
-	Host      string
-	BaseURL   string
-	APIPrefix string
-	Zone      string
-	Timeout   time.Duration
-	Retries   int
-	Auth      string
-	Headers   []string
-	DryRun    bool
-	Format    string
-	Verbose   int
-	Quiet     bool
-	NoColor   bool
+	Device     string
+	Devices    []string
+	All        bool
+	ConfigPath string
+	Host       string
+	BaseURL    string
+	APIPrefix  string
+	Zone       string
+	Timeout    time.Duration
+	Retries    int
+	Retry      yxc.RetryPolicy
+	Throttle   yxc.Throttle
+	Auth       string
+	Proxy      string
+	Headers    []string
+	DryRun     string
+	Record     string
+	Replay     string
+	NoValidate bool
+	Strict     bool
+	// FeaturesTTL is how long cached getFeatures results are trusted;
+	// FeaturesDir is where they are kept.
+	FeaturesTTL time.Duration
+	FeaturesDir string
+	Format      string
+	Fields      []string
+	Columns     []string
+	SortBy      string
+	Verbose     int
+	Quiet       bool
+	NoColor     bool
 }
 
 type App struct {
 	Options Options
+	client  *yxc.Client
+	out     io.Writer
+	// csvHeader is the last header written, so streamed records share it.
+	csvHeader []string
+	// features is the device's getFeatures, once needed; featuresErr is why
+	// they could not be fetched, so that the device is asked once per run.
+	features    *deviceCache
+	featuresErr error
 }
 
 func New(opts Options) *App {
-	return &App{Options: opts}
+	a := &App{Options: opts, out: os.Stdout}
+	a.client = a.newClient()
+	return a
 }
@@ -0,0 +1,320 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/amannm/yxc/internal/spec"
+	"github.com/spf13/cobra"
+)
+
+// Call invokes an operation from the bundled spec by operationId, with
+// parameters given as key=value pairs. Path parameters fill the templated
+// segments, query parameters go in the URL (zone defaults to --zone in
+// either) and anything else becomes a field of the JSON body of POST
+// operations; dotted keys set nested fields.
+func (a *App) Call(cmd *cobra.Command, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("call: requires an operation (see --list)")
+	}
+	data, err := cmd.Flags().GetString("data")
+	if err != nil {
+		return err
+	}
+	return a.CallOperation(cmd.Context(), args[0], args[1:], data)
+}
+
+// CallOperation sends the operation named name with key=value parameters,
+// and data as the JSON body when it is not empty.
+func (a *App) CallOperation(ctx context.Context, name string, pairs []string, data string) error {
+	s, err := spec.Load()
+	if err != nil {
+		return err
+	}
+	op, err := s.Find(name)
+	if err != nil {
+		return fmt.Errorf("call: %w", err)
+	}
+	pathParams := map[string]string{}
+	q := url.Values{}
+	fields := map[string]any{}
+	for _, pair := range pairs {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok || k == "" {
+			return fmt.Errorf("call: %s is not key=value", pair)
+		}
+		if p, ok := op.Param(k); ok {
+			switch p.In {
+			case "path":
+				pathParams[k] = v
+			default:
+				q.Add(k, p.Schema.Coerce(v))
+			}
+			continue
+		}
+		if op.Body == nil {
+			if !a.Options.NoValidate {
+				return fmt.Errorf("call: %s has no parameter %s (want %s)", op.ID, k, strings.Join(paramNames(op), ", "))
+			}
+			q.Add(k, v)
+			continue
+		}
+		if err := setField(fields, op.Body, k, v); err != nil {
+			return fmt.Errorf("call: %w", err)
+		}
+	}
+	// Required parameters are checked even with --no-validate, which only
+	// lets values the spec does not allow through.
+	path := strings.TrimPrefix(op.Path, "/v1/")
+	var missing []string
+	for _, p := range op.Params {
+		switch p.In {
+		case "path":
+			v, ok := pathParams[p.Name]
+			if !ok && p.Name == "zone" {
+				v, ok = zoneOrDefault(a.Options.Zone), true
+			}
+			if !ok {
+				missing = append(missing, p.Name+"=...")
+				continue
+			}
+			path = strings.ReplaceAll(path, "{"+p.Name+"}", url.PathEscape(p.Schema.Coerce(v)))
+		case "query":
+			if p.Name == "zone" && len(q[p.Name]) == 0 {
+				q.Set(p.Name, zoneOrDefault(a.Options.Zone))
+			}
+			if p.Required && len(q[p.Name]) == 0 {
+				missing = append(missing, p.Name+"=...")
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("call: %s requires %s", op.ID, strings.Join(missing, " "))
+	}
+	var body []byte
+	switch {
+	case strings.TrimSpace(data) != "":
+		if len(fields) > 0 {
+			return fmt.Errorf("call: --data cannot be combined with body fields")
+		}
+		if !json.Valid([]byte(data)) {
+			return fmt.Errorf("call: --data is not valid JSON")
+		}
+		body = []byte(data)
+	case len(fields) > 0 || (op.Method == http.MethodPost && op.Body != nil):
+		if body, err = json.Marshal(fields); err != nil {
+			return err
+		}
+	}
+	return a.call(ctx, op.Method, a.client.API(path), q, body, "application/json")
+}
+
+// setField stores a key=value pair in a JSON body, converting the value to
+// the type the schema gives the field. Values for arrays and objects are
+// JSON.
+func setField(fields map[string]any, body *spec.Schema, key, v string) error {
+	parts := strings.Split(key, ".")
+	schema := body
+	m := fields
+	for i, part := range parts {
+		if schema != nil {
+			schema = schema.Properties[part]
+		}
+		if i == len(parts)-1 {
+			break
+		}
+		next, ok := m[part].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			m[part] = next
+		}
+		m = next
+	}
+	val, err := bodyValue(schema, v)
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	m[parts[len(parts)-1]] = val
+	return nil
+}
+
+func bodyValue(s *spec.Schema, v string) (any, error) {
+	typ := ""
+	if s != nil {
+		typ = s.Type
+	}
+	v = s.Coerce(v)
+	switch typ {
+	case "string":
+		return v, nil
+	case "integer":
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", v)
+		}
+		return n, nil
+	case "number":
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", v)
+		}
+		return f, nil
+	case "boolean":
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not true or false", v)
+		}
+		return b, nil
+	case "array", "object":
+		var out any
+		if err := json.Unmarshal([]byte(v), &out); err != nil {
+			return nil, fmt.Errorf("%q is not a JSON %s", v, typ)
+		}
+		return out, nil
+	}
+	var out any
+	if json.Unmarshal([]byte(v), &out) == nil {
+		return out, nil
+	}
+	return v, nil
+}
+
+func paramNames(op *spec.Operation) []string {
+	names := make([]string, 0, len(op.Params))
+	for _, p := range op.Params {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+type operationSummary struct {
+	Operation string `json:"operation"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Summary   string `json:"summary"`
+}
+
+// CallList renders every operation in the bundled spec.
+func (a *App) CallList() error {
+	s, err := spec.Load()
+	if err != nil {
+		return err
+	}
+	out := make([]operationSummary, len(s.Operations))
+	for i, op := range s.Operations {
+		out[i] = operationSummary{Operation: op.ID, Method: op.Method, Path: op.Path, Summary: op.Summary}
+	}
+	return a.renderValue(out)
+}
+
+// CallHelp writes the spec's description of an operation: its summary,
+// endpoint, parameters and body fields.
+func CallHelp(w io.Writer, name string) error {
+	s, err := spec.Load()
+	if err != nil {
+		return err
+	}
+	op, err := s.Find(name)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s\n\n  %s %s\n", op.ID, op.Summary, op.Method, op.Path)
+	if op.Description != "" && op.Description != op.Summary {
+		fmt.Fprintf(&b, "\n%s\n", op.Description)
+	}
+	if len(op.Params) > 0 {
+		b.WriteString("\nParameters:\n")
+		for _, p := range op.Params {
+			writeField(&b, p.Name, p.Schema, p.Required, p.Description)
+		}
+	}
+	if op.Body != nil && len(op.Body.Properties) > 0 {
+		b.WriteString("\nBody fields (dotted keys for nested fields, JSON for lists and objects):\n")
+		writeBodyFields(&b, "", op.Body)
+	}
+	fmt.Fprintf(&b, "\nUsage:\n  yxc call %s", op.ID)
+	for _, p := range op.Params {
+		if p.Required && !(p.In == "path" && p.Name == "zone") {
+			fmt.Fprintf(&b, " %s=...", p.Name)
+		}
+	}
+	b.WriteString(" [key=value ...]\n")
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+func writeBodyFields(b *strings.Builder, prefix string, s *spec.Schema) {
+	names := make([]string, 0, len(s.Properties))
+	for k := range s.Properties {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		prop := s.Properties[k]
+		required := false
+		for _, r := range s.Required {
+			required = required || r == k
+		}
+		writeField(b, prefix+k, prop, required, prop.Description)
+		if prop.Type == "object" && len(prop.Properties) > 0 {
+			writeBodyFields(b, prefix+k+".", prop)
+		}
+	}
+}
+
+func writeField(b *strings.Builder, name string, s *spec.Schema, required bool, desc string) {
+	line := "  " + name + " (" + schemaType(s)
+	if required {
+		line += ", required"
+	}
+	if s != nil && s.Default != nil {
+		line += fmt.Sprintf(", default %v", s.Default)
+	}
+	line += ")"
+	if desc = strings.TrimSpace(desc); desc != "" {
+		line += "  " + strings.ReplaceAll(desc, "\n", " ")
+	}
+	b.WriteString(line + "\n")
+}
+
+func schemaType(s *spec.Schema) string {
+	if s == nil {
+		return "any"
+	}
+	if len(s.OneOf) > 0 {
+		alts := make([]string, len(s.OneOf))
+		for i, alt := range s.OneOf {
+			alts[i] = schemaType(alt)
+		}
+		return strings.Join(alts, " | ")
+	}
+	if len(s.Enum) > 0 {
+		return strings.Join(s.EnumValues(), "|")
+	}
+	t := s.Type
+	if t == "" {
+		t = "any"
+	}
+	if t == "array" && s.Items != nil {
+		t = "array of " + schemaType(s.Items)
+	}
+	if s.Minimum != nil || s.Maximum != nil {
+		lo, hi := "", ""
+		if s.Minimum != nil {
+			lo = strconv.FormatFloat(*s.Minimum, 'f', -1, 64)
+		}
+		if s.Maximum != nil {
+			hi = strconv.FormatFloat(*s.Maximum, 'f', -1, 64)
+		}
+		t += " " + lo + ".." + hi
+	}
+	return t
+}
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amannm/yxc/pkg/yxc/mock"
+)
+
+// TestCallOperation calls the mock device by operationId and endpoint name,
+// with values the spec's schemas coerce, and checks that ambiguous names
+// and missing required parameters fail before anything is sent.
+func TestCallOperation(t *testing.T) {
+	d := mock.New()
+	defer d.Close()
+	srv := httptest.NewServer(d)
+	defer srv.Close()
+	ctx := context.Background()
+	newApp := func(noValidate bool) (*App, *bytes.Buffer) {
+		var out bytes.Buffer
+		a := New(Options{BaseURL: srv.URL + "/YamahaExtendedControl", Format: "json", Timeout: 2 * time.Second, NoValidate: noValidate})
+		a.out = &out
+		return a, &out
+	}
+
+	a, _ := newApp(false)
+	for _, tc := range []struct {
+		name  string
+		pairs []string
+	}{
+		{"setVolume", []string{"zone=Main", "volume=40.0"}},
+		{"setzonemute", []string{"enable=on"}},
+	} {
+		if err := a.CallOperation(ctx, tc.name, tc.pairs, ""); err != nil {
+			t.Errorf("%s %v: %v", tc.name, tc.pairs, err)
+		}
+	}
+	status, err := a.client.Zone("main").GetStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Volume != 40 || !status.Mute {
+		t.Errorf("volume %d mute %v, want 40 true", status.Volume, status.Mute)
+	}
+
+	a, out := newApp(false)
+	if err := a.CallOperation(ctx, "getTunerPresetInfo", []string{"band=FM"}, ""); err != nil {
+		t.Fatal(err)
+	}
+	var info map[string]any
+	if err := json.Unmarshal(out.Bytes(), &info); err != nil || info["preset_info"] == nil {
+		t.Errorf("getTunerPresetInfo: %v: %s", err, out)
+	}
+
+	for _, tc := range []struct {
+		name       string
+		pairs      []string
+		noValidate bool
+		want       string
+	}{
+		{name: "getPresetInfo", want: "getPresetInfo is ambiguous: getNetUsbPresetInfo, getTunerPresetInfo"},
+		{name: "getTunerPresetInfo", want: "getTunerPresetInfo requires band=..."},
+		{name: "getTunerPresetInfo", noValidate: true, want: "getTunerPresetInfo requires band=..."},
+		{name: "setZoneVolume", pairs: []string{"step=2"}, noValidate: true, want: "setZoneVolume requires volume=..."},
+		{name: "setVolume", pairs: []string{"bogus=1"}, want: "setZoneVolume has no parameter bogus"},
+	} {
+		a, _ := newApp(tc.noValidate)
+		err := a.CallOperation(ctx, tc.name, tc.pairs, "")
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s %v: got %v, want %q", tc.name, tc.pairs, err, tc.want)
+		}
+	}
+	if n := d.Calls("tuner/getPresetInfo"); n != 1 {
+		t.Errorf("tuner/getPresetInfo called %d times, want 1", n)
+	}
+	if n := d.Calls("main/setVolume"); n != 1 {
+		t.Errorf("main/setVolume called %d times, want 1", n)
+	}
+}
@@ -0,0 +1,242 @@
+package app
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/amannm/yxc/internal/spec"
+	"github.com/amannm/yxc/pkg/yxc"
+)
+
+// zoneFuncs names the func_list entry a zone endpoint needs.
+var zoneFuncs = map[string]string{
+	"setPower":               "power",
+	"setSleep":               "sleep",
+	"setVolume":              "volume",
+	"setMute":                "mute",
+	"setSoundProgram":        "sound_program",
+	"set3dSurround":          "surround_3d",
+	"setDirect":              "direct",
+	"setPureDirect":          "pure_direct",
+	"setEnhancer":            "enhancer",
+	"setToneControl":         "tone_control",
+	"setEqualizer":           "equalizer",
+	"setBalance":             "balance",
+	"setDialogueLevel":       "dialogue_level",
+	"setDialogueLift":        "dialogue_lift",
+	"setClearVoice":          "clear_voice",
+	"setSubwooferVolume":     "subwoofer_volume",
+	"setBassExtension":       "bass_extension",
+	"getSignalInfo":          "signal_info",
+	"prepareInputChange":     "prepare_input_change",
+	"recallScene":            "scene",
+	"setContentsDisplay":     "contents_display",
+	"controlCursor":          "cursor",
+	"executeMenu":            "menu",
+	"setActualVolume":        "actual_volume",
+	"setSurroundDecoderType": "surr_decoder_type",
+	"setLinkControl":         "link_control",
+	"setLinkAudioDelay":      "link_audio_delay",
+	"setLinkAudioQuality":    "link_audio_quality",
+}
+
+// zoneRanges names the range_step entry that bounds each numeric parameter
+// of a zone endpoint.
+var zoneRanges = map[string]map[string]string{
+	"setVolume":          {"volume": "volume"},
+	"setToneControl":     {"bass": "tone_control", "treble": "tone_control"},
+	"setEqualizer":       {"low": "equalizer", "mid": "equalizer", "high": "equalizer"},
+	"setBalance":         {"value": "balance"},
+	"setDialogueLevel":   {"value": "dialogue_level"},
+	"setDialogueLift":    {"value": "dialogue_lift"},
+	"setSubwooferVolume": {"volume": "subwoofer_volume"},
+}
+
+// systemFuncs names the system func_list entry a system endpoint needs.
+var systemFuncs = map[string]string{
+	"setWiredLan":           "wired_lan",
+	"setWirelessLan":        "wireless_lan",
+	"setWirelessDirect":     "wireless_direct",
+	"setAirPlayPin":         "airplay",
+	"getNetworkStandby":     "network_standby",
+	"setNetworkStandby":     "network_standby",
+	"setBluetoothStandby":   "bluetooth_standby",
+	"setBluetoothTxSetting": "bluetooth_tx_setting",
+	"setAutoPowerStandby":   "auto_power_standby",
+	"setIrSensor":           "ir_sensor",
+	"setSpeakerA":           "speaker_a",
+	"setSpeakerB":           "speaker_b",
+	"setDimmer":             "dimmer",
+	"setZoneBVolumeSync":    "zone_b_volume_sync",
+	"setHdmiOut1":           "hdmi_out_1",
+	"setHdmiOut2":           "hdmi_out_2",
+	"setAutoPlay":           "auto_play",
+	"setSpeakerPattern":     "speaker_pattern",
+	"setPartyMode":          "party_mode",
+	"getRemoteInfo":         "remote_info",
+}
+
+// UnsupportedError reports a request the device's getFeatures says it
+// cannot handle.
+type UnsupportedError struct {
+	Model     string
+	Zone      string
+	What      string
+	Available []string
+}
+
+func (e *UnsupportedError) Error() string {
+	msg := fmt.Sprintf("%s is not supported on %s", e.What, e.Model)
+	if e.Zone != "" {
+		msg += " (zone " + e.Zone + ")"
+	}
+	if len(e.Available) > 0 {
+		msg += "; available: " + strings.Join(e.Available, ", ")
+	}
+	return msg
+}
+
+// checkCapabilities rejects a request that getFeatures rules out: a zone,
+// function, input or sound program the model does not have, or a value
+// outside its range_step. Devices whose features cannot be had are not
+// checked.
+func (a *App) checkCapabilities(req *http.Request, op *spec.Operation, path string) error {
+	endpoint := path[strings.LastIndex(path, "/")+1:]
+	var needed bool
+	switch op.Tag {
+	case "Zone":
+		needed = true
+	case "System":
+		_, needed = systemFuncs[endpoint]
+	case "Tuner":
+		needed = endpoint == "setBand" || endpoint == "setFreq"
+	}
+	if !needed {
+		return nil
+	}
+	f, err := a.cachedDevice(req.Context(), req.URL.Scheme+"://"+req.URL.Host)
+	if err != nil || f == nil || f.features == nil {
+		return nil
+	}
+	q := req.URL.Query()
+	switch op.Tag {
+	case "Zone":
+		return checkZone(f, endpoint, op.PathParams(path)["zone"], q)
+	case "System":
+		if fn := systemFuncs[endpoint]; len(f.features.System.FuncList) > 0 && !slices.Contains(f.features.System.FuncList, fn) {
+			return &UnsupportedError{Model: f.model(), What: fn}
+		}
+	case "Tuner":
+		return checkTuner(f, endpoint, q)
+	}
+	return nil
+}
+
+func checkZone(f *deviceCache, endpoint, zone string, q url.Values) error {
+	if len(f.features.Zone) == 0 {
+		return nil
+	}
+	z, ok := f.features.ZoneFeature(zone)
+	if !ok {
+		return &UnsupportedError{Model: f.model(), What: "zone " + zone}
+	}
+	if fn, ok := zoneFuncs[endpoint]; ok && !slices.Contains(z.FuncList, fn) {
+		return &UnsupportedError{Model: f.model(), Zone: zone, What: fn}
+	}
+	choice := func(param, kind string, list []string) error {
+		v := q.Get(param)
+		if v == "" || len(list) == 0 || slices.Contains(list, v) {
+			return nil
+		}
+		return &UnsupportedError{Model: f.model(), Zone: zone, What: kind + " " + v, Available: list}
+	}
+	switch endpoint {
+	case "setInput", "prepareInputChange":
+		return choice("input", "input", z.InputList)
+	case "setSoundProgram":
+		return choice("program", "sound program", z.SoundProgramList)
+	case "controlCursor":
+		return choice("cursor", "cursor", z.CursorList)
+	case "executeMenu":
+		return choice("menu", "menu", z.MenuList)
+	case "setToneControl":
+		if err := choice("mode", "tone control mode", z.ToneControlModeList); err != nil {
+			return err
+		}
+	case "setEqualizer":
+		if err := choice("mode", "equalizer mode", z.EqualizerModeList); err != nil {
+			return err
+		}
+	case "setSurroundDecoderType":
+		return choice("type", "surround decoder", z.SurrDecoderTypeList)
+	case "setLinkControl":
+		return choice("control", "link control", z.LinkControlList)
+	case "setLinkAudioDelay":
+		return choice("delay", "link audio delay", z.LinkAudioDelayList)
+	case "setLinkAudioQuality":
+		return choice("quality", "link audio quality", z.LinkAudioQualityList)
+	case "recallScene":
+		if n, err := strconv.Atoi(q.Get("num")); err == nil && z.SceneNum > 0 && n > z.SceneNum {
+			return fmt.Errorf("scene %d: %s has %d scenes", n, f.model(), z.SceneNum)
+		}
+	case "setActualVolume":
+		mode := q.Get("mode")
+		if err := choice("mode", "actual volume mode", z.ActualVolumeModeList); err != nil {
+			return err
+		}
+		if r, ok := z.Range("actual_volume_" + mode); ok {
+			return checkRange(f.model(), "value", q.Get("value"), r)
+		}
+	}
+	for _, param := range slices.Sorted(maps.Keys(zoneRanges[endpoint])) {
+		if r, ok := z.Range(zoneRanges[endpoint][param]); ok {
+			if err := checkRange(f.model(), param, q.Get(param), r); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkTuner(f *deviceCache, endpoint string, q url.Values) error {
+	band := q.Get("band")
+	funcs := f.features.Tuner.FuncList
+	if band == "" || band == "common" || len(funcs) == 0 {
+		return nil
+	}
+	if !slices.Contains(funcs, band) {
+		return &UnsupportedError{Model: f.model(), What: "tuner band " + band}
+	}
+	if endpoint == "setFreq" && q.Get("tuning") == "direct" {
+		if r, ok := f.features.TunerRange(band); ok {
+			return checkRange(f.model(), "num", q.Get("num"), r)
+		}
+	}
+	return nil
+}
+
+// checkRange checks a numeric parameter against a range_step entry. Values
+// that are not numbers (e.g. volume=up) are left to the device.
+func checkRange(model, param, v string, r yxc.RangeStep) error {
+	n, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return nil
+	}
+	format := func(f float64) string { return strconv.FormatFloat(f, 'f', -1, 64) }
+	if n < r.Min || n > r.Max {
+		return fmt.Errorf("%s %s is outside %s..%s on %s", param, v, format(r.Min), format(r.Max), model)
+	}
+	if r.Step > 0 {
+		steps := (n - r.Min) / r.Step
+		if math.Abs(steps-math.Round(steps)) > 1e-6 {
+			return fmt.Errorf("%s %s is not in steps of %s from %s on %s", param, v, format(r.Step), format(r.Min), model)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/amannm/yxc/pkg/yxc"
+	"github.com/amannm/yxc/pkg/yxc/mock"
+)
+
+func testFeatures(t *testing.T) *deviceCache {
+	t.Helper()
+	raw := []byte(`{
+		"response_code": 0,
+		"zone": [{
+			"id": "main",
+			"func_list": ["power", "volume", "balance"],
+			"input_list": ["hdmi1", "net_radio"],
+			"range_step": [
+				{"id": "volume", "min": 0, "max": 161, "step": 1},
+				{"id": "balance", "min": -10, "max": 10, "step": 2}
+			]
+		}],
+		"tuner": {"func_list": ["fm"], "range_step": [{"id": "fm", "min": 87500, "max": 108000, "step": 50}]}
+	}`)
+	f := &deviceCache{Fetched: time.Now(), Features: raw, features: &yxc.Features{}}
+	f.DeviceInfo.ModelName = "RX-TEST"
+	f.DeviceInfo.DeviceID = "ABC123"
+	if err := json.Unmarshal(raw, f.features); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestCheckZone(t *testing.T) {
+	f := testFeatures(t)
+	for _, tc := range []struct {
+		endpoint, zone, query string
+		unsupported, bad      bool
+	}{
+		{endpoint: "setVolume", zone: "main", query: "volume=40"},
+		{endpoint: "setVolume", zone: "main", query: "volume=up"},
+		{endpoint: "setVolume", zone: "main", query: "volume=162", bad: true},
+		{endpoint: "setBalance", zone: "main", query: "value=4"},
+		{endpoint: "setBalance", zone: "main", query: "value=3", bad: true},
+		{endpoint: "setDialogueLift", zone: "main", query: "value=1", unsupported: true},
+		{endpoint: "setPower", zone: "zone2", query: "power=on", unsupported: true},
+		{endpoint: "setInput", zone: "main", query: "input=hdmi1"},
+		{endpoint: "setInput", zone: "main", query: "input=hdmi9", unsupported: true},
+	} {
+		q, _ := url.ParseQuery(tc.query)
+		err := checkZone(f, tc.endpoint, tc.zone, q)
+		var uerr *UnsupportedError
+		switch {
+		case tc.unsupported && !errors.As(err, &uerr):
+			t.Errorf("%s %s: got %v, want unsupported", tc.endpoint, tc.query, err)
+		case tc.bad && (err == nil || errors.As(err, &uerr)):
+			t.Errorf("%s %s: got %v, want a range error", tc.endpoint, tc.query, err)
+		case !tc.unsupported && !tc.bad && err != nil:
+			t.Errorf("%s %s: %v", tc.endpoint, tc.query, err)
+		}
+	}
+}
+
+func TestCheckTuner(t *testing.T) {
+	f := testFeatures(t)
+	if err := checkTuner(f, "setBand", url.Values{"band": {"am"}}); err == nil {
+		t.Error("am accepted on an FM-only tuner")
+	}
+	if err := checkTuner(f, "setFreq", url.Values{"band": {"fm"}, "tuning": {"direct"}, "num": {"87525"}}); err == nil {
+		t.Error("87525 accepted with a 50 kHz step")
+	}
+	if err := checkTuner(f, "setFreq", url.Values{"band": {"fm"}, "tuning": {"direct"}, "num": {"87550"}}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFeaturesCache(t *testing.T) {
+	dir := t.TempDir()
+	f := testFeatures(t)
+	if err := writeCachedFeatures(dir, "http://10.0.0.5", f); err != nil {
+		t.Fatal(err)
+	}
+	got := readCachedFeatures(dir, "http://10.0.0.5", time.Hour)
+	if got == nil {
+		t.Fatal("cached features not found")
+	}
+	if got.model() != "RX-TEST" || len(got.features.Zone) != 1 {
+		t.Errorf("got %+v", got)
+	}
+	if readCachedFeatures(dir, "http://10.0.0.6", time.Hour) != nil {
+		t.Error("found features for an unknown host")
+	}
+	f.Fetched = time.Now().Add(-2 * time.Hour)
+	if err := writeCachedFeatures(dir, "http://10.0.0.5", f); err != nil {
+		t.Fatal(err)
+	}
+	if readCachedFeatures(dir, "http://10.0.0.5", time.Hour) != nil {
+		t.Error("expired features were used")
+	}
+}
+
+// TestFeaturesUnavailable checks that a device failing getDeviceInfo is
+// probed once, without the retries the command's own requests get, and that
+// the error is kept rather than the features silently left nil.
+func TestFeaturesUnavailable(t *testing.T) {
+	d := mock.New()
+	defer d.Close()
+	var probes atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/system/getDeviceInfo") {
+			probes.Add(1)
+			http.Error(w, "busy", http.StatusServiceUnavailable)
+			return
+		}
+		d.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+	a := New(Options{
+		BaseURL: srv.URL + "/YamahaExtendedControl",
+		Format:  "json",
+		Timeout: 2 * time.Second,
+		Retries: 3,
+		Retry:   yxc.RetryPolicy{Delay: 100 * time.Millisecond},
+		Quiet:   true,
+	})
+	a.out = io.Discard
+	start := time.Now()
+	for range 2 {
+		if err := a.CallOperation(ctx, "setVolume", []string{"volume=40"}, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("two requests took %s", elapsed)
+	}
+	if n := probes.Load(); n != 1 {
+		t.Errorf("getDeviceInfo sent %d times, want 1", n)
+	}
+	var herr *yxc.HTTPError
+	if _, err := a.cachedDevice(ctx, srv.URL); !errors.As(err, &herr) || herr.Status != http.StatusServiceUnavailable {
+		t.Errorf("cachedDevice: %v, want the 503 from getDeviceInfo", err)
+	}
+	if n := d.Calls("main/setVolume"); n != 2 {
+		t.Errorf("main/setVolume called %d times, want 2", n)
+	}
+}
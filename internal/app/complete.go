@@ -0,0 +1,202 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/amannm/yxc/internal/spec"
+	"github.com/amannm/yxc/pkg/yxc"
+)
+
+// Fallbacks for when the device's getFeatures cannot be had: the most
+// scenes and NetUSB presets a device has. Inputs and sound programs fall
+// back to the IDs the spec documents.
+const (
+	maxScenes        = 8
+	maxNetUSBPresets = 40
+)
+
+// completionTimeout bounds the requests a completion may send to fill the
+// cache, so that a slow or absent device does not stall the shell.
+const completionTimeout = 1500 * time.Millisecond
+
+// Complete returns shell completion candidates, as "value\tdescription"
+// where there is a description. what is one of zone, input, sound-program,
+// scene, tuner-band and netusb-preset. Candidates come from the cached
+// getFeatures, getPresetInfo and getNameText of the configured device,
+// fetching them once if they are not cached yet, and from the bundled spec
+// and the API's documented IDs when the device cannot be reached.
+func (a *App) Complete(ctx context.Context, what string) []string {
+	ctx, cancel := context.WithTimeout(ctx, completionTimeout)
+	defer cancel()
+	host, f := a.currentDevice(ctx)
+	zone := zoneOrDefault(a.Options.Zone)
+	switch what {
+	case "zone":
+		if f != nil && len(f.features.Zone) > 0 {
+			out := make([]string, len(f.features.Zone))
+			for i, z := range f.features.Zone {
+				out[i] = z.ID
+			}
+			return out
+		}
+		return specEnum("ZoneId")
+	case "input":
+		if f != nil {
+			if z, ok := f.features.ZoneFeature(zone); ok && len(z.InputList) > 0 {
+				a.fetchNameText(ctx, host, f, z.InputList)
+				return described(z.InputList, f.NameText)
+			}
+		}
+		return specParamEnum("setZoneInput", "input")
+	case "sound-program":
+		if f != nil {
+			if z, ok := f.features.ZoneFeature(zone); ok && len(z.SoundProgramList) > 0 {
+				return z.SoundProgramList
+			}
+		}
+		return specParamEnum("setZoneSoundProgram", "program")
+	case "scene":
+		if f != nil {
+			if z, ok := f.features.ZoneFeature(zone); ok && z.SceneNum > 0 {
+				return numbers(z.SceneNum)
+			}
+		}
+		return numbers(maxScenes)
+	case "tuner-band":
+		bands := specParamEnum("setTunerBand", "band")
+		if f == nil || len(f.features.Tuner.FuncList) == 0 {
+			return bands
+		}
+		var out []string
+		for _, b := range bands {
+			for _, fn := range f.features.Tuner.FuncList {
+				if b == fn {
+					out = append(out, b)
+				}
+			}
+		}
+		return out
+	case "netusb-preset":
+		if f != nil {
+			if presets := a.fetchNetPresets(ctx, host, f); len(presets) > 0 {
+				var out []string
+				for i, p := range presets {
+					if p.Input == "" || p.Input == "unknown" {
+						continue
+					}
+					desc := p.Text
+					if desc == "" {
+						desc = p.Input
+					}
+					out = append(out, strconv.Itoa(i+1)+"\t"+desc)
+				}
+				return out
+			}
+			if n := f.features.Netusb.Preset.Num; n > 0 {
+				return numbers(n)
+			}
+		}
+		return numbers(maxNetUSBPresets)
+	}
+	return nil
+}
+
+// currentDevice finds the cached entry of the configured device, or fetches
+// it. The entry is nil when no device is configured or its features cannot
+// be had.
+func (a *App) currentDevice(ctx context.Context) (string, *deviceCache) {
+	req, err := a.client.BuildRequest(ctx, http.MethodGet, a.client.API(""), nil, nil, "")
+	if err != nil || req.URL.Host == "" {
+		return "", nil
+	}
+	host := req.URL.Scheme + "://" + req.URL.Host
+	f, err := a.cachedDevice(ctx, host)
+	if err != nil || f == nil || f.features == nil {
+		return host, nil
+	}
+	return host, f
+}
+
+// fetchNameText fills in the names given to ids, the first time they are
+// needed.
+func (a *App) fetchNameText(ctx context.Context, host string, f *deviceCache, ids []string) {
+	if f.NameText != nil || a.Options.Replay != "" {
+		return
+	}
+	names := map[string]string{}
+	for _, id := range ids {
+		resp, err := a.client.System().GetNameText(ctx, id)
+		if err != nil {
+			return
+		}
+		if resp.Text != "" && resp.Text != id {
+			names[id] = resp.Text
+		}
+	}
+	f.NameText = names
+	a.saveDevice(host, f)
+}
+
+func (a *App) fetchNetPresets(ctx context.Context, host string, f *deviceCache) []yxc.NetUSBPreset {
+	if f.NetPresets == nil && a.Options.Replay == "" {
+		if resp, err := a.client.NetUSB().GetPresetInfo(ctx); err == nil {
+			f.NetPresets = resp.PresetInfo
+			a.saveDevice(host, f)
+		}
+	}
+	return f.NetPresets
+}
+
+func described(values []string, names map[string]string) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = v
+		if name := names[v]; name != "" {
+			out[i] += "\t" + name
+		}
+	}
+	return out
+}
+
+func numbers(n int) []string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = strconv.Itoa(i + 1)
+	}
+	return out
+}
+
+func specEnum(component string) []string {
+	s, err := spec.Load()
+	if err != nil {
+		return nil
+	}
+	c, ok := s.Schema(component)
+	if !ok {
+		return nil
+	}
+	return c.EnumValues()
+}
+
+func specParam(operation, param string) *spec.Schema {
+	s, err := spec.Load()
+	if err != nil {
+		return nil
+	}
+	op, ok := s.Operation(operation)
+	if !ok {
+		return nil
+	}
+	p, ok := op.Param(param)
+	if !ok {
+		return nil
+	}
+	return p.Schema
+}
+
+func specParamEnum(operation, param string) []string {
+	return specParam(operation, param).EnumValues()
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestComplete(t *testing.T) {
+	a := New(Options{Host: "10.0.0.5"})
+	a.features = testFeatures(t)
+	a.features.NameText = map[string]string{"hdmi1": "TV"}
+	for what, want := range map[string][]string{
+		"zone":       {"main"},
+		"input":      {"hdmi1\tTV", "net_radio"},
+		"tuner-band": {"fm"},
+	} {
+		if got := a.Complete(context.Background(), what); !slices.Equal(got, want) {
+			t.Errorf("Complete(%s) = %q, want %q", what, got, want)
+		}
+	}
+
+	offline := New(Options{})
+	if got := offline.Complete(context.Background(), "zone"); !slices.Equal(got, []string{"main", "zone2", "zone3", "zone4"}) {
+		t.Errorf("offline zones = %q", got)
+	}
+	if got := offline.Complete(context.Background(), "scene"); len(got) != 8 {
+		t.Errorf("offline scenes = %q", got)
+	}
+	if got := offline.Complete(context.Background(), "input"); !slices.Contains(got, "hdmi1") || !slices.Equal(got, specEnum("InputId")) {
+		t.Errorf("offline inputs = %q", got)
+	}
+	if got := offline.Complete(context.Background(), "sound-program"); !slices.Contains(got, "straight") || !slices.Equal(got, specEnum("SoundProgramId")) {
+		t.Errorf("offline sound programs = %q", got)
+	}
+}
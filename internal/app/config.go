@@ -0,0 +1,176 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+type ConfigFile struct {
+	DefaultDevice string                    `yaml:"default_device,omitempty" json:"default_device,omitempty"`
+	Devices       map[string]*DeviceProfile `yaml:"devices,omitempty" json:"devices,omitempty"`
+}
+
+type DeviceProfile struct {
+	Host    string   `yaml:"host,omitempty" json:"host,omitempty"`
+	BaseURL string   `yaml:"base_url,omitempty" json:"base_url,omitempty"`
+	Auth    string   `yaml:"auth,omitempty" json:"auth,omitempty"`
+	Headers []string `yaml:"headers,omitempty" json:"headers,omitempty"`
+	Timeout string   `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	Retries *int     `yaml:"retries,omitempty" json:"retries,omitempty"`
+	Zone    string   `yaml:"zone,omitempty" json:"zone,omitempty"`
+}
+
+func DefaultConfigPath() string {
+	if p := strings.TrimSpace(os.Getenv("YXC_CONFIG")); p != "" {
+		return p
+	}
+	dir := strings.TrimSpace(os.Getenv("XDG_CONFIG_HOME"))
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "yxc", "config.yaml")
+}
+
+// DefaultLockDir is where per-host lock files that serialize requests across
+// yxc processes are kept.
+func DefaultLockDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "yxc", "locks")
+}
+
+func LoadConfig(path string) (*ConfigFile, error) {
+	cfg := &ConfigFile{Devices: map[string]*DeviceProfile{}}
+	if path == "" {
+		return cfg, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	if cfg.Devices == nil {
+		cfg.Devices = map[string]*DeviceProfile{}
+	}
+	return cfg, nil
+}
+
+func (c *ConfigFile) Save(path string) error {
+	if path == "" {
+		return errors.New("config: no config path")
+	}
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, data)
+}
+
+// writeFileAtomic replaces path with data through a uniquely named
+// temporary file in the same directory, so that concurrent yxc processes
+// (fan-out runs, config and presets commands, the features cache) never
+// write into each other's temporary file or leave a partial one behind.
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+func (c *ConfigFile) DeviceNames() []string {
+	names := make([]string, 0, len(c.Devices))
+	for name := range c.Devices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ApplyProfile fills opts from the selected device profile. changed reports
+// whether a flag was set explicitly; those values are left alone. With no
+// --device, the default device is used unless --host or --base-url was given.
+// Fan-out runs resolve a profile per device instead, see Run.
+func ApplyProfile(opts *Options, changed func(string) bool) error {
+	if opts.All || len(opts.Devices) > 0 {
+		return nil
+	}
+	cfg, err := LoadConfig(opts.ConfigPath)
+	if err != nil {
+		return err
+	}
+	name := strings.TrimSpace(opts.Device)
+	if name == "" {
+		if changed("host") || changed("base-url") {
+			return nil
+		}
+		name = cfg.DefaultDevice
+	}
+	if name == "" {
+		return nil
+	}
+	p, ok := cfg.Devices[name]
+	if !ok {
+		return fmt.Errorf("config: unknown device %s", name)
+	}
+	opts.Device = name
+	if !changed("host") && !changed("base-url") {
+		opts.Host = p.Host
+		opts.BaseURL = p.BaseURL
+	}
+	if !changed("auth") && p.Auth != "" {
+		opts.Auth = p.Auth
+	}
+	if !changed("header") && len(p.Headers) > 0 {
+		opts.Headers = p.Headers
+	}
+	if !changed("timeout") && p.Timeout != "" {
+		d, err := time.ParseDuration(p.Timeout)
+		if err != nil {
+			return fmt.Errorf("config: device %s: invalid timeout %s", name, p.Timeout)
+		}
+		opts.Timeout = d
+	}
+	if !changed("retries") && p.Retries != nil {
+		opts.Retries = *p.Retries
+	}
+	if !changed("zone") && p.Zone != "" {
+		opts.Zone = p.Zone
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+type namedProfile struct {
+	Name    string `json:"name"`
+	Default bool   `json:"default,omitempty"`
+	*DeviceProfile
+}
+
+func (a *App) Config(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("config: missing subcommand")
+	}
+	path := a.Options.ConfigPath
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		return err
+	}
+	switch args[0] {
+	case "path":
+		_, err := fmt.Fprintln(a.out, path)
+		return err
+	case "list":
+		items := []namedProfile{}
+		for _, name := range cfg.DeviceNames() {
+			items = append(items, namedProfile{Name: name, Default: name == cfg.DefaultDevice, DeviceProfile: cfg.Devices[name]})
+		}
+		return a.renderValue(items)
+	case "show":
+		name := a.Options.Device
+		if len(args) > 1 {
+			name = args[1]
+		}
+		if name == "" {
+			name = cfg.DefaultDevice
+		}
+		if name == "" {
+			return fmt.Errorf("config show: no device given and no default device set")
+		}
+		p, ok := cfg.Devices[name]
+		if !ok {
+			return fmt.Errorf("config show: unknown device %s", name)
+		}
+		return a.renderValue(namedProfile{Name: name, Default: name == cfg.DefaultDevice, DeviceProfile: p})
+	case "add":
+		if len(args) < 2 {
+			return fmt.Errorf("config add: missing device name")
+		}
+		name := strings.TrimSpace(args[1])
+		p := a.profileFromFlags(cmd)
+		if p.Host == "" && p.BaseURL == "" {
+			return fmt.Errorf("config add: --host or --base-url is required")
+		}
+		makeDefault, err := cmd.Flags().GetBool("default")
+		if err != nil {
+			return err
+		}
+		cfg.Devices[name] = p
+		if makeDefault || len(cfg.Devices) == 1 {
+			cfg.DefaultDevice = name
+		}
+		if err := cfg.Save(path); err != nil {
+			return err
+		}
+		return a.renderValue(namedProfile{Name: name, Default: name == cfg.DefaultDevice, DeviceProfile: p})
+	case "remove":
+		if len(args) < 2 {
+			return fmt.Errorf("config remove: missing device name")
+		}
+		name := args[1]
+		if _, ok := cfg.Devices[name]; !ok {
+			return fmt.Errorf("config remove: unknown device %s", name)
+		}
+		delete(cfg.Devices, name)
+		if cfg.DefaultDevice == name {
+			cfg.DefaultDevice = ""
+		}
+		if err := cfg.Save(path); err != nil {
+			return err
+		}
+		if !a.Options.Quiet {
+			_, _ = fmt.Fprintf(os.Stderr, "removed device %s\n", name)
+		}
+		return nil
+	case "use":
+		if len(args) < 2 {
+			return fmt.Errorf("config use: missing device name")
+		}
+		if _, ok := cfg.Devices[args[1]]; !ok {
+			return fmt.Errorf("config use: unknown device %s", args[1])
+		}
+		cfg.DefaultDevice = args[1]
+		return cfg.Save(path)
+	default:
+		return fmt.Errorf("config: unknown command %s", args[0])
+	}
+}
+
+// profileFromFlags builds a profile from the global connection flags that
+// were set explicitly on this invocation.
+func (a *App) profileFromFlags(cmd *cobra.Command) *DeviceProfile {
+	f := cmd.Flags()
+	p := &DeviceProfile{}
+	if f.Changed("host") {
+		p.Host = a.Options.Host
+	}
+	if f.Changed("base-url") {
+		p.BaseURL = a.Options.BaseURL
+	}
+	if f.Changed("auth") {
+		p.Auth = a.Options.Auth
+	}
+	if f.Changed("header") {
+		p.Headers = a.Options.Headers
+	}
+	if f.Changed("timeout") {
+		p.Timeout = a.Options.Timeout.String()
+	}
+	if f.Changed("retries") {
+		retries := a.Options.Retries
+		p.Retries = &retries
+	}
+	if f.Changed("zone") {
+		p.Zone = a.Options.Zone
+	}
+	return p
+}
+
+func (a *App) renderValue(v any) error {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return a.render(out)
+}
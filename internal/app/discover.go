@@ -1,17 +1,18 @@
 package app
 
 import (
-	"bufio"
-	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
+	"fmt"
 	"net"
-	"os/exec"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/amannm/yxc/pkg/yxc"
+	"github.com/spf13/cobra"
 )
 
 type discoveredDevice struct {
@@ -22,13 +23,28 @@ type discoveredDevice struct {
 	Port      int      `json:"port"`
 	Addresses []string `json:"addresses,omitempty"`
 	BaseURL   string   `json:"base_url"`
+	Source    string   `json:"source"`
+
+	ModelName     string   `json:"model_name"`
+	DeviceID      string   `json:"device_id"`
+	SystemVersion float64  `json:"system_version"`
+	Zones         []string `json:"zones"`
 }
 
-func (a *App) Discover() error {
-	devs, err := browseMusicCast()
+func (a *App) Discover(cmd *cobra.Command) error {
+	protocol, err := cmd.Flags().GetString("protocol")
+	if err != nil {
+		return err
+	}
+	wait, err := cmd.Flags().GetDuration("wait")
 	if err != nil {
 		return err
 	}
+	devs, err := discoverDevices(cmd.Context(), protocol, wait)
+	if err != nil {
+		return err
+	}
+	devs = a.probeDevices(cmd.Context(), devs)
 	out, err := json.Marshal(devs)
 	if err != nil {
 		return err
@@ -37,22 +53,129 @@ func (a *App) Discover() error {
 }
 
 func browseMusicCast() ([]discoveredDevice, error) {
-	types := []string{"_http._tcp"}
+	return browseMusicCastContext(context.Background())
+}
+
+func browseMusicCastContext(ctx context.Context) ([]discoveredDevice, error) {
+	devs, err := discoverDevices(ctx, "all", 3*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return New(Options{}).probeDevices(ctx, devs), nil
+}
+
+// probeDevices keeps only candidates that answer getDeviceInfo with a
+// response_code, filling in identity and, when getFeatures answers, zones
+// from the device itself.
+func (a *App) probeDevices(ctx context.Context, candidates []discoveredDevice) []discoveredDevice {
+	probed := make([]*discoveredDevice, len(candidates))
+	var wg sync.WaitGroup
+	for i, d := range candidates {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := a.probeDevice(ctx, &d); err == nil {
+				probed[i] = &d
+			}
+		}()
+	}
+	wg.Wait()
+	devs := []discoveredDevice{}
+	seen := map[string]struct{}{}
+	for _, d := range probed {
+		if d == nil {
+			continue
+		}
+		key := d.DeviceID
+		if key == "" {
+			key = d.BaseURL
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		devs = append(devs, *d)
+	}
+	return devs
+}
+
+func (a *App) probeDevice(ctx context.Context, d *discoveredDevice) error {
+	if d.BaseURL == "" {
+		return fmt.Errorf("discover: %s has no base url", d.Name)
+	}
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+	c := yxc.New(yxc.Config{BaseURL: d.BaseURL, APIPrefix: a.Options.APIPrefix, Timeout: 2 * time.Second})
+	info, err := c.System().GetDeviceInfo(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := yxc.ResponseCode(info.Raw()); !ok {
+		return fmt.Errorf("discover: %s did not answer getDeviceInfo", d.Name)
+	}
+	d.ModelName = info.ModelName
+	d.DeviceID = info.DeviceID
+	d.SystemVersion = info.SystemVersion
+	d.Zones = []string{}
+	// The device has already shown it is MusicCast; one that cannot report
+	// its features is still listed, just without zones.
+	features, err := c.System().GetFeatures(ctx)
+	if err != nil {
+		return nil
+	}
+	for _, z := range features.Zone {
+		d.Zones = append(d.Zones, z.ID)
+	}
+	return nil
+}
+
+func discoverDevices(ctx context.Context, protocol string, timeout time.Duration) ([]discoveredDevice, error) {
+	type browser func(context.Context, time.Duration) ([]discoveredDevice, error)
+	browsers := []browser{}
+	switch strings.ToLower(strings.TrimSpace(protocol)) {
+	case "", "all":
+		browsers = append(browsers, browseHTTP, searchSSDP)
+	case "mdns":
+		browsers = append(browsers, browseHTTP)
+	case "ssdp":
+		browsers = append(browsers, searchSSDP)
+	default:
+		return nil, fmt.Errorf("discover: unknown protocol %s", protocol)
+	}
+	results := make([][]discoveredDevice, len(browsers))
+	errs := make([]error, len(browsers))
+	var wg sync.WaitGroup
+	for i, b := range browsers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = b(ctx, timeout)
+		}()
+	}
+	wg.Wait()
 	all := []discoveredDevice{}
 	seen := map[string]struct{}{}
 	var lastErr error
-	for _, t := range types {
-		items, err := browseService(t, 3*time.Second)
-		if err != nil {
-			lastErr = err
+	for i, items := range results {
+		if errs[i] != nil {
+			lastErr = errs[i]
 			continue
 		}
 		for _, item := range items {
-			key := item.Name + "|" + item.Type + "|" + item.Domain
-			if _, ok := seen[key]; ok {
+			keys := deviceKeys(item)
+			dup := false
+			for _, k := range keys {
+				if _, ok := seen[k]; ok {
+					dup = true
+					break
+				}
+			}
+			if dup {
 				continue
 			}
-			seen[key] = struct{}{}
+			for _, k := range keys {
+				seen[k] = struct{}{}
+			}
 			all = append(all, item)
 		}
 	}
@@ -68,144 +191,26 @@ func browseMusicCast() ([]discoveredDevice, error) {
 	return all, nil
 }
 
-func browseService(service string, timeout time.Duration) ([]discoveredDevice, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-	cmd := exec.CommandContext(ctx, "dns-sd", "-B", service)
-	out, err := cmd.StdoutPipe()
-	if err != nil {
-		return nil, err
-	}
-	if err := cmd.Start(); err != nil {
-		return nil, err
-	}
-	scanner := bufio.NewScanner(out)
-	type entry struct {
-		Name   string
-		Type   string
-		Domain string
-	}
-	entries := []entry{}
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" || strings.HasPrefix(line, "Browsing") || strings.HasPrefix(line, "DATE:") {
-			continue
-		}
-		fields := strings.Fields(line)
-		if len(fields) < 6 {
-			continue
-		}
-		action := fields[1]
-		if action != "Add" {
-			continue
-		}
-		domain := strings.TrimSuffix(fields[4], ".")
-		svc := strings.TrimSuffix(fields[5], ".")
-		name := strings.Join(fields[6:], " ")
-		if name == "" {
-			continue
-		}
-		entries = append(entries, entry{Name: name, Type: svc, Domain: domain})
-	}
-	_ = cmd.Process.Kill()
-	_ = cmd.Wait()
-	if len(entries) == 0 {
-		return []discoveredDevice{}, nil
-	}
-	results := []discoveredDevice{}
-	for _, e := range entries {
-		dev, err := resolveService(e.Name, e.Type, e.Domain, 2*time.Second)
-		if err == nil {
-			results = append(results, dev)
-		}
-	}
-	return results, nil
+func browseHTTP(ctx context.Context, timeout time.Duration) ([]discoveredDevice, error) {
+	return browseService(ctx, "_http._tcp", timeout)
 }
 
-func resolveService(name, service, domain string, timeout time.Duration) (discoveredDevice, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-	cmd := exec.CommandContext(ctx, "dns-sd", "-L", name, service, domain)
-	out, err := cmd.StdoutPipe()
-	if err != nil {
-		return discoveredDevice{}, err
-	}
-	if err := cmd.Start(); err != nil {
-		return discoveredDevice{}, err
-	}
-	scanner := bufio.NewScanner(out)
-	host, port := "", 0
-	for scanner.Scan() {
-		line := scanner.Text()
-		if strings.Contains(line, "can be reached at") {
-			host, port = parseResolveOutput([]byte(line))
-			break
-		}
-	}
-	_ = cmd.Process.Kill()
-	_ = cmd.Wait()
-	if host == "" {
-		if err := scanner.Err(); err != nil {
-			return discoveredDevice{}, err
-		}
-		if ctx.Err() != nil {
-			return discoveredDevice{}, ctx.Err()
-		}
-		return discoveredDevice{}, errors.New("resolve failed")
-	}
-	host = strings.TrimSuffix(host, ".")
-	addrs := []string{}
-	if host != "" {
-		if ips, err := net.LookupIP(host); err == nil {
-			for _, ip := range ips {
-				addrs = append(addrs, ip.String())
-			}
-		}
-	}
-	baseURL := ""
-	if host != "" {
-		if port == 0 || port == 80 {
-			baseURL = "http://" + host + "/YamahaExtendedControl"
-		} else {
-			baseURL = "http://" + host + ":" + strconv.Itoa(port) + "/YamahaExtendedControl"
-		}
+func deviceKeys(d discoveredDevice) []string {
+	keys := []string{d.Name + "|" + d.Type + "|" + d.Domain}
+	for _, addr := range d.Addresses {
+		keys = append(keys, "addr|"+addr+"|"+strconv.Itoa(d.Port))
 	}
-	return discoveredDevice{
-		Name:      name,
-		Type:      service,
-		Domain:    domain,
-		Host:      host,
-		Port:      port,
-		Addresses: addrs,
-		BaseURL:   baseURL,
-	}, nil
+	return keys
 }
 
-func parseResolveOutput(out []byte) (string, int) {
-	scanner := bufio.NewScanner(bytes.NewReader(out))
-	for scanner.Scan() {
-		line := scanner.Text()
-		idx := strings.Index(line, "can be reached at")
-		if idx == -1 {
-			continue
-		}
-		rest := strings.TrimSpace(line[idx+len("can be reached at"):])
-		fields := strings.Fields(rest)
-		if len(fields) == 0 {
-			continue
-		}
-		hostPort := fields[0]
-		hostPort = strings.TrimSuffix(hostPort, ".")
-		if strings.Contains(hostPort, ":") {
-			parts := strings.Split(hostPort, ":")
-			host := strings.Join(parts[:len(parts)-1], ":")
-			portStr := parts[len(parts)-1]
-			if port, err := strconv.Atoi(portStr); err == nil {
-				return host, port
-			}
-			return host, 0
-		}
-		return hostPort, 0
+func deviceBaseURL(host string, port int) string {
+	if host == "" {
+		return ""
+	}
+	if port != 0 && port != 80 {
+		host = net.JoinHostPort(host, strconv.Itoa(port))
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]"
 	}
-	return "", 0
+	return "http://" + host + "/YamahaExtendedControl"
 }
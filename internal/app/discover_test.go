@@ -1,8 +1,14 @@
 package app
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"slices"
 	"testing"
+
+	"github.com/amannm/yxc/pkg/yxc/mock"
 )
 
 func TestDiscoverLive(t *testing.T) {
@@ -29,3 +35,46 @@ func TestDiscoverLive(t *testing.T) {
 		t.Logf("%s %s %s:%d %s", d.Name, d.Type, d.Host, d.Port, d.BaseURL)
 	}
 }
+
+// TestProbeDevices probes a mock device next to an HTTP service that is
+// not MusicCast, once with getFeatures answering and once with it failing.
+func TestProbeDevices(t *testing.T) {
+	d := mock.New()
+	defer d.Close()
+	srv := httptest.NewServer(d)
+	defer srv.Close()
+	printer := httptest.NewServer(http.NotFoundHandler())
+	defer printer.Close()
+	candidates := []discoveredDevice{
+		{Name: "Living Room", BaseURL: srv.URL + "/YamahaExtendedControl"},
+		{Name: "Printer", BaseURL: printer.URL + "/YamahaExtendedControl"},
+		{Name: "No URL"},
+	}
+
+	for _, tc := range []struct {
+		name  string
+		fail  bool
+		zones []string
+	}{
+		{name: "features", zones: []string{"main", "zone2"}},
+		{name: "features failing", fail: true, zones: []string{}},
+	} {
+		if tc.fail {
+			d.Fail("system/getFeatures", 3)
+		}
+		devs := New(Options{}).probeDevices(context.Background(), candidates)
+		if len(devs) != 1 {
+			t.Fatalf("%s: probed %+v, want only Living Room", tc.name, devs)
+		}
+		got := devs[0]
+		if got.Name != "Living Room" || got.ModelName != "RX-V6A" || got.DeviceID != d.DeviceID() || got.SystemVersion == 0 {
+			t.Errorf("%s: device %+v", tc.name, got)
+		}
+		if !slices.Equal(got.Zones, tc.zones) {
+			t.Errorf("%s: zones %q, want %q", tc.name, got.Zones, tc.zones)
+		}
+	}
+	if n := d.Calls("system/getFeatures"); n != 2 {
+		t.Errorf("getFeatures called %d times, want 2", n)
+	}
+}
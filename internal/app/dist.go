@@ -1,11 +1,10 @@
 package app
 
 import (
-	"encoding/json"
 	"fmt"
-	"net/url"
 	"strings"
 
+	"github.com/amannm/yxc/pkg/yxc"
 	"github.com/spf13/cobra"
 )
 
@@ -13,42 +12,43 @@ func (a *App) Dist(cmd *cobra.Command, args []string) error {
 	if len(args) == 0 {
 		return fmt.Errorf("dist: missing subcommand")
 	}
+	ctx := cmd.Context()
+	d := a.client.Dist()
 	switch args[0] {
-	case "info":
-		return a.get(a.api("dist/getDistributionInfo"), nil)
 	case "server":
 		body, err := readJSONFromFlags(cmd, "file", "stdin")
 		if err != nil {
 			return err
 		}
-		return a.post(a.api("dist/setServerInfo"), nil, body)
+		var req yxc.ServerInfoRequest
+		if err := decodeJSON(body, &req); err != nil {
+			return fmt.Errorf("dist server: %w", err)
+		}
+		return a.show(d.SetServerInfo(ctx, req))
 	case "client":
 		body, err := readJSONFromFlags(cmd, "file", "stdin")
 		if err != nil {
 			return err
 		}
-		return a.post(a.api("dist/setClientInfo"), nil, body)
-	case "start":
-		num, err := cmd.Flags().GetInt("num")
-		if err != nil {
-			return err
+		var req yxc.ClientInfoRequest
+		if err := decodeJSON(body, &req); err != nil {
+			return fmt.Errorf("dist client: %w", err)
 		}
-		q := url.Values{}
-		q.Set("num", fmt.Sprintf("%d", num))
-		return a.get(a.api("dist/startDistribution"), q)
-	case "stop":
-		return a.get(a.api("dist/stopDistribution"), nil)
+		return a.show(d.SetClientInfo(ctx, req))
 	case "group-name":
 		useStdin, err := cmd.Flags().GetBool("stdin")
 		if err != nil {
 			return err
 		}
-		var body []byte
+		var req yxc.GroupNameRequest
 		if useStdin {
-			body, err = readStdin()
+			body, err := readStdin()
 			if err != nil {
 				return err
 			}
+			if err := decodeJSON(body, &req); err != nil {
+				return fmt.Errorf("dist group-name: %w", err)
+			}
 		} else {
 			name, err := cmd.Flags().GetString("name")
 			if err != nil {
@@ -57,12 +57,9 @@ func (a *App) Dist(cmd *cobra.Command, args []string) error {
 			if strings.TrimSpace(name) == "" {
 				return fmt.Errorf("dist group-name: --name or --stdin is required")
 			}
-			body, err = json.Marshal(map[string]any{"name": name})
-			if err != nil {
-				return err
-			}
+			req.Name = name
 		}
-		return a.post(a.api("dist/setGroupName"), nil, body)
+		return a.show(d.SetGroupName(ctx, req))
 	default:
 		return fmt.Errorf("dist: unknown command %s", args[0])
 	}
@@ -0,0 +1,334 @@
+package app
+
+import (
+	"fmt"
+	"go/format"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/amannm/yxc/pkg/yxc"
+)
+
+// DryRunModes lists the --dry-run renderings.
+var DryRunModes = []string{"raw", "curl", "httpie", "go"}
+
+// CheckDryRun rejects an unknown --dry-run mode before any request is built.
+func CheckDryRun(mode string) error {
+	if mode == "" || slices.Contains(DryRunModes, strings.ToLower(strings.TrimSpace(mode))) {
+		return nil
+	}
+	return fmt.Errorf("unknown --dry-run mode %s (want %s)", mode, strings.Join(DryRunModes, "|"))
+}
+
+func (a *App) printRequest(req *http.Request, body []byte) error {
+	var out string
+	switch strings.ToLower(strings.TrimSpace(a.Options.DryRun)) {
+	case "raw":
+		out = rawRequest(req, body)
+	case "curl":
+		out = curlRequest(req, body)
+	case "httpie":
+		out = httpieRequest(req, body)
+	case "go":
+		out = a.goRequest(req, body)
+	default:
+		return CheckDryRun(a.Options.DryRun)
+	}
+	_, err := io.WriteString(a.out, out)
+	return err
+}
+
+func rawRequest(req *http.Request, body []byte) string {
+	var b strings.Builder
+	b.WriteString(req.Method)
+	b.WriteString(" ")
+	b.WriteString(req.URL.String())
+	b.WriteString("\n")
+	for _, k := range sortedHeaderKeys(req.Header) {
+		for _, v := range req.Header[k] {
+			b.WriteString(k)
+			b.WriteString(": ")
+			b.WriteString(v)
+			b.WriteString("\n")
+		}
+	}
+	if len(body) > 0 {
+		b.WriteString("\n")
+		b.Write(body)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func curlRequest(req *http.Request, body []byte) string {
+	parts := []string{"curl"}
+	if req.Method != http.MethodGet || len(body) > 0 {
+		parts = append(parts, "-X", req.Method)
+	}
+	user, pass, hasAuth := req.BasicAuth()
+	if hasAuth {
+		parts = append(parts, "-u", shellQuote(user+":"+pass))
+	}
+	for _, k := range sortedHeaderKeys(req.Header) {
+		if hasAuth && k == "Authorization" {
+			continue
+		}
+		for _, v := range req.Header[k] {
+			parts = append(parts, "-H", shellQuote(k+": "+v))
+		}
+	}
+	if len(body) > 0 {
+		parts = append(parts, "--data-raw", shellQuote(string(body)))
+	}
+	parts = append(parts, shellQuote(req.URL.String()))
+	return strings.Join(parts, " ") + "\n"
+}
+
+func httpieRequest(req *http.Request, body []byte) string {
+	parts := []string{"http"}
+	user, pass, hasAuth := req.BasicAuth()
+	if hasAuth {
+		parts = append(parts, "--auth", shellQuote(user+":"+pass))
+	}
+	if len(body) > 0 {
+		parts = append(parts, "--raw", shellQuote(string(body)))
+	}
+	parts = append(parts, req.Method, shellQuote(req.URL.String()))
+	for _, k := range sortedHeaderKeys(req.Header) {
+		if hasAuth && k == "Authorization" {
+			continue
+		}
+		for _, v := range req.Header[k] {
+			parts = append(parts, shellQuote(k+":"+v))
+		}
+	}
+	return strings.Join(parts, " ") + "\n"
+}
+
+// goRequest renders a program making the request, through the typed client
+// when it has a method for the endpoint and with net/http otherwise.
+func (a *App) goRequest(req *http.Request, body []byte) string {
+	src := ""
+	if call, ok := a.typedCall(req, body); ok {
+		src = a.goTypedProgram(call)
+	} else {
+		src = goHTTPProgram(req, body)
+	}
+	if out, err := format.Source([]byte(src)); err == nil {
+		return string(out)
+	}
+	return src
+}
+
+func (a *App) goTypedProgram(call string) string {
+	cfg := []string{}
+	if a.client.Config.BaseURL != "" {
+		cfg = append(cfg, "BaseURL: "+strconv.Quote(a.client.Config.BaseURL))
+	} else {
+		cfg = append(cfg, "Host: "+strconv.Quote(a.client.Config.Host))
+	}
+	if p := a.client.Config.APIPrefix; p != "" && p != "/v1" {
+		cfg = append(cfg, "APIPrefix: "+strconv.Quote(p))
+	}
+	if a.client.Config.Auth != "" {
+		cfg = append(cfg, "Auth: "+strconv.Quote(a.client.Config.Auth))
+	}
+	if len(a.client.Config.Headers) > 0 {
+		quoted := make([]string, len(a.client.Config.Headers))
+		for i, h := range a.client.Config.Headers {
+			quoted[i] = strconv.Quote(h)
+		}
+		cfg = append(cfg, "Headers: []string{"+strings.Join(quoted, ", ")+"}")
+	}
+	var b strings.Builder
+	b.WriteString("package main\n\nimport (\n\t\"context\"\n\t\"fmt\"\n\t\"log\"\n\n\t\"github.com/amannm/yxc/pkg/yxc\"\n)\n\n")
+	b.WriteString("func main() {\n\tctx := context.Background()\n\tc := yxc.New(yxc.Config{\n")
+	for _, line := range cfg {
+		b.WriteString("\t\t" + line + ",\n")
+	}
+	b.WriteString("\t})\n")
+	b.WriteString("\tresp, err := c." + call + "\n")
+	b.WriteString("\tif err != nil {\n\t\tlog.Fatal(err)\n\t}\n")
+	b.WriteString("\tfmt.Println(string(resp.Raw()))\n}\n")
+	return b.String()
+}
+
+func goHTTPProgram(req *http.Request, body []byte) string {
+	var b strings.Builder
+	b.WriteString("package main\n\nimport (\n\t\"fmt\"\n\t\"io\"\n\t\"log\"\n\t\"net/http\"\n")
+	if len(body) > 0 {
+		b.WriteString("\t\"strings\"\n")
+	}
+	b.WriteString(")\n\nfunc main() {\n")
+	bodyExpr := "nil"
+	if len(body) > 0 {
+		bodyExpr = "strings.NewReader(" + strconv.Quote(string(body)) + ")"
+	}
+	fmt.Fprintf(&b, "\treq, err := http.NewRequest(%s, %s, %s)\n", strconv.Quote(req.Method), strconv.Quote(req.URL.String()), bodyExpr)
+	b.WriteString("\tif err != nil {\n\t\tlog.Fatal(err)\n\t}\n")
+	user, pass, hasAuth := req.BasicAuth()
+	for _, k := range sortedHeaderKeys(req.Header) {
+		if hasAuth && k == "Authorization" {
+			continue
+		}
+		for _, v := range req.Header[k] {
+			fmt.Fprintf(&b, "\treq.Header.Add(%s, %s)\n", strconv.Quote(k), strconv.Quote(v))
+		}
+	}
+	if hasAuth {
+		fmt.Fprintf(&b, "\treq.SetBasicAuth(%s, %s)\n", strconv.Quote(user), strconv.Quote(pass))
+	}
+	b.WriteString("\tresp, err := http.DefaultClient.Do(req)\n")
+	b.WriteString("\tif err != nil {\n\t\tlog.Fatal(err)\n\t}\n")
+	b.WriteString("\tdefer resp.Body.Close()\n")
+	b.WriteString("\tout, err := io.ReadAll(resp.Body)\n")
+	b.WriteString("\tif err != nil {\n\t\tlog.Fatal(err)\n\t}\n")
+	b.WriteString("\tfmt.Println(string(out))\n}\n")
+	return b.String()
+}
+
+// typedParams gives the query parameter behind each argument of typed
+// client methods that take more than one. Single-argument methods take the
+// request's only query parameter.
+var typedParams = map[string][]string{
+	"system/setNameText":      {"id", "text"},
+	"zone/setInput":           {"input", "mode"},
+	"tuner/recallPreset":      {"zone", "band", "num"},
+	"tuner/clearPreset":       {"band", "num"},
+	"tuner/movePreset":        {"band", "from", "to"},
+	"netusb/recallPreset":     {"zone", "num"},
+	"netusb/movePreset":       {"from", "to"},
+	"netusb/recallRecentItem": {"zone", "num"},
+	"netusb/setQuality":       {"input", "value"},
+	"netusb/getServiceInfo":   {"input", "type"},
+}
+
+// typedCall maps a GET request back to the typed client method that builds
+// it, e.g. `Zone("main").SetVolume(ctx, 40)`. Requests with a body, or whose
+// parameters do not line up with a method's arguments, have no typed form.
+func (a *App) typedCall(req *http.Request, body []byte) (string, bool) {
+	if req.Method != http.MethodGet || len(body) > 0 {
+		return "", false
+	}
+	prefix := a.client.API("")
+	i := strings.LastIndex(req.URL.Path, prefix)
+	if i < 0 {
+		return "", false
+	}
+	group, op, ok := strings.Cut(req.URL.Path[i+len(prefix):], "/")
+	if !ok || op == "" || strings.Contains(op, "/") {
+		return "", false
+	}
+	q := req.URL.Query()
+	for _, vals := range q {
+		if len(vals) != 1 {
+			return "", false
+		}
+	}
+	var service string
+	var svc reflect.Type
+	key := group + "/" + op
+	switch group {
+	case "main", "zone2", "zone3", "zone4":
+		service, svc, key = "Zone("+strconv.Quote(group)+")", reflect.TypeFor[*yxc.ZoneService](), "zone/"+op
+	case "system":
+		service, svc = "System()", reflect.TypeFor[*yxc.SystemService]()
+	case "tuner":
+		service, svc = "Tuner()", reflect.TypeFor[*yxc.TunerService]()
+	case "netusb":
+		service, svc = "NetUSB()", reflect.TypeFor[*yxc.NetUSBService]()
+	case "cd":
+		service, svc = "CD()", reflect.TypeFor[*yxc.CDService]()
+	case "clock":
+		service, svc = "Clock()", reflect.TypeFor[*yxc.ClockService]()
+	case "dist":
+		service, svc = "Dist()", reflect.TypeFor[*yxc.DistService]()
+	default:
+		return "", false
+	}
+	name := strings.ToUpper(op[:1]) + op[1:]
+	params := typedParams[key]
+	if key == "zone/setVolume" && (q.Get("volume") == "up" || q.Get("volume") == "down") {
+		name = "Volume" + strings.ToUpper(q.Get("volume")[:1]) + q.Get("volume")[1:]
+		q.Del("volume")
+	}
+	m, ok := svc.MethodByName(name)
+	if !ok {
+		return "", false
+	}
+	// Receiver and context come first.
+	nargs := m.Type.NumIn() - 2
+	if params == nil {
+		params = make([]string, 0, len(q))
+		for k := range q {
+			params = append(params, k)
+		}
+	}
+	if len(params) != nargs {
+		return "", false
+	}
+	used := 0
+	args := []string{"ctx"}
+	for j, p := range params {
+		v, present := q[p]
+		if present {
+			used++
+		}
+		arg, ok := goLiteral(m.Type.In(j+2), strings.Join(v, ""), present)
+		if !ok {
+			return "", false
+		}
+		args = append(args, arg)
+	}
+	if used != len(q) {
+		return "", false
+	}
+	return service + "." + name + "(" + strings.Join(args, ", ") + ")", true
+}
+
+func goLiteral(t reflect.Type, v string, present bool) (string, bool) {
+	switch t.Kind() {
+	case reflect.String:
+		return strconv.Quote(v), true
+	case reflect.Int:
+		if !present {
+			return "", false
+		}
+		if _, err := strconv.Atoi(v); err != nil {
+			return "", false
+		}
+		return v, true
+	case reflect.Bool:
+		b, err := strconv.ParseBool(v)
+		if !present || err != nil {
+			return "", false
+		}
+		return strconv.FormatBool(b), true
+	default:
+		return "", false
+	}
+}
+
+func sortedHeaderKeys(h http.Header) []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// shellQuote quotes s for POSIX shells.
+func shellQuote(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./:=@%+,", r))
+	}) < 0 {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,123 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/amannm/yxc/pkg/yxc"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// TestDryRunGolden renders the same requests in every --dry-run mode and
+// compares them with testdata/dryrun/<mode>[-post].golden.
+func TestDryRunGolden(t *testing.T) {
+	ctx := context.Background()
+	for _, mode := range DryRunModes {
+		for _, tc := range []struct {
+			name string
+			send func(c *yxc.Client) error
+		}{
+			{mode, func(c *yxc.Client) error {
+				_, err := c.Zone("main").SetVolume(ctx, 40)
+				return err
+			}},
+			{mode + "-post", func(c *yxc.Client) error {
+				_, err := c.Do(ctx, http.MethodPost, c.API("clock/setAlarmSettings"), nil, []byte(`{"alarm_on":true,"name":"it's"}`), "application/json")
+				return err
+			}},
+		} {
+			var out bytes.Buffer
+			a := New(Options{Host: "192.168.1.50", Auth: "admin:secret", Headers: []string{"X-Trace: 1"}, NoValidate: true, DryRun: mode})
+			a.out = &out
+			if err := tc.send(a.client); err != nil {
+				t.Fatalf("%s: %v", tc.name, err)
+			}
+			path := filepath.Join("testdata", "dryrun", tc.name+".golden")
+			if *update {
+				if err := os.WriteFile(path, out.Bytes(), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				continue
+			}
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != string(want) {
+				t.Errorf("%s:\n%s\nwant:\n%s", tc.name, out.String(), want)
+			}
+		}
+	}
+}
+
+// TestTypedCall checks that typedCall maps requests back to the methods
+// that built them, and that typedParams covers methods that exist with the
+// arguments it lists.
+func TestTypedCall(t *testing.T) {
+	ctx := context.Background()
+	a := New(Options{Host: "192.168.1.50", NoValidate: true})
+	var req *http.Request
+	a.client.DryRun = func(r *http.Request, body []byte) error {
+		req = r
+		return nil
+	}
+	c := a.client
+	covered := map[string]bool{}
+	for want, send := range map[string]func() error{
+		`Zone("main").SetVolume(ctx, 40)`: func() error { _, err := c.Zone("main").SetVolume(ctx, 40); return err },
+		`Zone("zone2").VolumeUp(ctx, 2)`:  func() error { _, err := c.Zone("zone2").VolumeUp(ctx, 2); return err },
+		`Zone("main").SetMute(ctx, true)`: func() error { _, err := c.Zone("main").SetMute(ctx, true); return err },
+		`Zone("main").SetInput(ctx, "hdmi1", "")`: func() error {
+			_, err := c.Zone("main").SetInput(ctx, "hdmi1", "")
+			return err
+		},
+		`Zone("main").SetInput(ctx, "net_radio", "autoplay_disabled")`: func() error {
+			_, err := c.Zone("main").SetInput(ctx, "net_radio", "autoplay_disabled")
+			return err
+		},
+		`System().SetNameText(ctx, "hdmi1", "TV")`: func() error { _, err := c.System().SetNameText(ctx, "hdmi1", "TV"); return err },
+		`Tuner().RecallPreset(ctx, "main", "fm", 3)`: func() error {
+			_, err := c.Tuner().RecallPreset(ctx, "main", "fm", 3)
+			return err
+		},
+		`Tuner().ClearPreset(ctx, "fm", 3)`:         func() error { _, err := c.Tuner().ClearPreset(ctx, "fm", 3); return err },
+		`Tuner().MovePreset(ctx, "fm", 1, 2)`:       func() error { _, err := c.Tuner().MovePreset(ctx, "fm", 1, 2); return err },
+		`NetUSB().RecallPreset(ctx, "main", 5)`:     func() error { _, err := c.NetUSB().RecallPreset(ctx, "main", 5); return err },
+		`NetUSB().MovePreset(ctx, 1, 2)`:            func() error { _, err := c.NetUSB().MovePreset(ctx, 1, 2); return err },
+		`NetUSB().RecallRecentItem(ctx, "main", 1)`: func() error { _, err := c.NetUSB().RecallRecentItem(ctx, "main", 1); return err },
+		`NetUSB().SetQuality(ctx, "qobuz", "hi_res")`: func() error {
+			_, err := c.NetUSB().SetQuality(ctx, "qobuz", "hi_res")
+			return err
+		},
+		`NetUSB().GetServiceInfo(ctx, "pandora", "account_list")`: func() error {
+			_, err := c.NetUSB().GetServiceInfo(ctx, "pandora", "account_list")
+			return err
+		},
+	} {
+		req = nil
+		if err := send(); err != nil || req == nil {
+			t.Fatalf("%s: %v", want, err)
+		}
+		got, ok := a.typedCall(req, nil)
+		if !ok || got != want {
+			t.Errorf("typedCall(%s) = %q, %v; want %q", req.URL, got, ok, want)
+		}
+		group, op, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, "/YamahaExtendedControl/v1/"), "/")
+		if strings.HasPrefix(group, "zone") || group == "main" {
+			group = "zone"
+		}
+		covered[group+"/"+op] = true
+	}
+	for key := range typedParams {
+		if !covered[key] {
+			t.Errorf("typedParams[%s] is not exercised by this test", key)
+		}
+	}
+}
@@ -1,8 +1,13 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
+
+	"github.com/amannm/yxc/pkg/yxc"
 )
 
 func ErrNotImplemented(cmd string, args []string) error {
@@ -12,3 +17,85 @@ func ErrNotImplemented(cmd string, args []string) error {
 	}
 	return fmt.Errorf("%s%s: not implemented", cmd, suffix)
 }
+
+// Process exit codes. Device response codes map to fixed exit codes so that
+// scripts can tell, say, Guarded from Firmware Updating; streaming service
+// (100-115) and distribution (200-201) codes exit with the code itself.
+const (
+	ExitOK              = 0
+	ExitError           = 1
+	ExitHTTP            = 2
+	ExitUnknownResponse = 10
+	ExitInitializing    = 11
+	ExitInternal        = 12
+	ExitInvalidRequest  = 13
+	ExitInvalidParam    = 14
+	ExitGuarded         = 15
+	ExitTimeout         = 16
+	ExitFirmwareUpdate  = 19
+	ExitInterrupted     = 130
+)
+
+// ExitCodesHelp documents the exit codes for the command help.
+const ExitCodesHelp = `Exit codes:
+  0        success
+  1        error (usage, network, config, partial fan-out failure)
+  2        HTTP error status from the device
+  10       unlisted response_code
+  11       response_code 1   Initializing
+  12       response_code 2   Internal Error
+  13       response_code 3   Invalid Request
+  14       response_code 4   Invalid Parameter
+  15       response_code 5   Guarded
+  16       response_code 6   Time Out
+  19       response_code 99  Firmware Updating
+  100-115  response_code 100-115  streaming service errors (same number)
+  200-201  response_code 200-201  distribution errors (same number)
+  130      interrupted (SIGINT/SIGTERM)`
+
+// ExitCode maps an error returned by a command to the documented exit code.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	if errors.Is(err, context.Canceled) {
+		return ExitInterrupted
+	}
+	var rerr *yxc.ResponseError
+	if errors.As(err, &rerr) {
+		switch {
+		case rerr.Code >= 1 && rerr.Code <= 6:
+			return ExitUnknownResponse + rerr.Code
+		case rerr.Code == 99:
+			return ExitFirmwareUpdate
+		case rerr.Code >= 100 && rerr.Code <= 115, rerr.Code == 200, rerr.Code == 201:
+			return rerr.Code
+		default:
+			return ExitUnknownResponse
+		}
+	}
+	var herr *yxc.HTTPError
+	if errors.As(err, &herr) {
+		return ExitHTTP
+	}
+	return ExitError
+}
+
+// ReportError prints err for a failed command. Outside the json format a
+// device response code is followed by an explanation of what it means.
+func ReportError(w io.Writer, err error, format string) {
+	if errors.Is(err, context.Canceled) {
+		_, _ = fmt.Fprintln(w, "Error: interrupted")
+		return
+	}
+	_, _ = fmt.Fprintf(w, "Error: %v\n", err)
+	if strings.EqualFold(strings.TrimSpace(format), "json") {
+		return
+	}
+	var rerr *yxc.ResponseError
+	if errors.As(err, &rerr) {
+		if explanation := rerr.Explanation(); explanation != "" {
+			_, _ = fmt.Fprintf(w, "%s: %s\n", rerr.Meaning(), explanation)
+		}
+	}
+}
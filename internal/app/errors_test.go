@@ -0,0 +1,51 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"syscall"
+	"testing"
+
+	"github.com/amannm/yxc/pkg/yxc"
+)
+
+func TestExitCode(t *testing.T) {
+	response := func(code int) error { return &yxc.ResponseError{Endpoint: "main/setVolume", Code: code} }
+	refused := &url.Error{Op: "Get", URL: "http://192.168.1.50/YamahaExtendedControl/v1/main/getStatus",
+		Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}}
+	for _, tc := range []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, ExitOK},
+		{"plain", errors.New("zone volume: missing value"), ExitError},
+		{"initializing", response(1), 11},
+		{"internal", response(2), 12},
+		{"invalid request", response(3), 13},
+		{"invalid param", response(4), 14},
+		{"guarded", response(5), 15},
+		{"time out", response(6), 16},
+		{"unlisted", response(7), ExitUnknownResponse},
+		{"firmware", response(99), ExitFirmwareUpdate},
+		{"streaming first", response(100), 100},
+		{"streaming last", response(115), 115},
+		{"past streaming", response(116), ExitUnknownResponse},
+		{"distribution", response(200), 200},
+		{"distribution last", response(201), 201},
+		{"past distribution", response(202), ExitUnknownResponse},
+		{"wrapped response", fmt.Errorf("living room: %w", response(5)), 15},
+		{"http", &yxc.HTTPError{Endpoint: "main/getStatus", Status: 503}, ExitHTTP},
+		{"interrupted", fmt.Errorf("watch: %w", context.Canceled), ExitInterrupted},
+		{"network", refused, ExitError},
+		{"deadline", context.DeadlineExceeded, ExitError},
+		{"dns", &net.DNSError{Err: "no such host", Name: "receiver.local", IsNotFound: true}, ExitError},
+	} {
+		if got := ExitCode(tc.err); got != tc.want {
+			t.Errorf("%s: ExitCode(%v) = %d, want %d", tc.name, tc.err, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amannm/yxc/pkg/yxc"
+	"github.com/spf13/cobra"
+)
+
+func (a *App) Events(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("events: missing subcommand")
+	}
+	ctx := cmd.Context()
+	switch args[0] {
+	case "watch":
+		l, appName, renew, err := a.listenEvents(cmd)
+		if err != nil {
+			return fmt.Errorf("events watch: %w", err)
+		}
+		defer l.Close()
+		return interrupted(ctx, a.client.Watch(ctx, l, appName, renew, func(e *yxc.Event) error {
+			return a.render(e.Raw())
+		}))
+	default:
+		return fmt.Errorf("events: unknown command %s", args[0])
+	}
+}
+
+// interrupted treats cancellation of a long-running command, e.g. by Ctrl-C,
+// as a clean stop rather than an error.
+func interrupted(ctx context.Context, err error) error {
+	if err != nil && ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+		return nil
+	}
+	return err
+}
+
+func (a *App) listenEvents(cmd *cobra.Command) (*yxc.EventListener, string, time.Duration, error) {
+	port, err := cmd.Flags().GetInt("port")
+	if err != nil {
+		return nil, "", 0, err
+	}
+	appName, err := cmd.Flags().GetString("app-name")
+	if err != nil {
+		return nil, "", 0, err
+	}
+	renew, err := cmd.Flags().GetDuration("renew")
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if strings.TrimSpace(appName) == "" {
+		appName = "MusicCast/" + Version + "(" + runtime.GOOS + ")"
+	}
+	l, err := yxc.ListenEvents(net.JoinHostPort("", strconv.Itoa(port)))
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if a.Options.Verbose > 0 && !a.Options.Quiet {
+		_, _ = fmt.Fprintf(os.Stderr, "listening for events on udp port %d\n", l.Port())
+	}
+	return l, appName, renew, nil
+}
@@ -0,0 +1,214 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+)
+
+type fanOutTarget struct {
+	name string
+	opts Options
+}
+
+type fanOutResult struct {
+	Host     string `json:"host"`
+	OK       bool   `json:"ok"`
+	Result   any    `json:"result,omitempty"`
+	Error    string `json:"error,omitempty"`
+	ExitCode int    `json:"exit_code,omitempty"`
+}
+
+// Run calls fn with an App for the selected device. With --devices or --all
+// it calls fn for every device concurrently, captures each device's output
+// and renders a single result keyed by device name. A failing device is
+// reported in its own entry and does not stop the others.
+func Run(cmd *cobra.Command, opts Options, fn func(a *App) error) error {
+	if !opts.All && len(opts.Devices) == 0 {
+		return fn(New(opts))
+	}
+	if cmd.Flags().Changed("host") || cmd.Flags().Changed("base-url") {
+		return fmt.Errorf("--host and --base-url cannot be combined with --devices or --all")
+	}
+	targets, err := fanOutTargets(cmd.Context(), opts, cmd.Flags().Changed)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("no devices to run against")
+	}
+	results := make([]fanOutResult, len(targets))
+	var wg sync.WaitGroup
+	for i, t := range targets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var buf bytes.Buffer
+			// Templates and --field apply per device; everything else is
+			// captured as JSON and rendered once below.
+			if _, ok := templateText(t.opts.Format); !ok {
+				t.opts.Format = "json"
+			}
+			a := New(t.opts)
+			a.out = &buf
+			err := fn(a)
+			r := fanOutResult{Host: t.opts.Host, OK: err == nil, Result: decodeOutput(buf.Bytes())}
+			if r.Host == "" {
+				r.Host = t.opts.BaseURL
+			}
+			if err != nil {
+				r.Error = err.Error()
+				r.ExitCode = ExitCode(err)
+			}
+			results[i] = r
+		}()
+	}
+	wg.Wait()
+	base := New(opts)
+	base.out = cmd.OutOrStdout()
+	base.Options.Fields = nil
+	if _, ok := templateText(opts.Format); ok {
+		base.Options.Format = "pretty"
+	}
+	var out []byte
+	// Row formats get one row per device; the others a map keyed by name.
+	switch strings.ToLower(strings.TrimSpace(opts.Format)) {
+	case "table", "csv", "ndjson":
+		rows := make([]map[string]any, 0, len(targets))
+		for i, t := range targets {
+			row := map[string]any{}
+			if m, ok := results[i].Result.(map[string]any); ok {
+				for k, v := range m {
+					row[k] = v
+				}
+			} else if results[i].Result != nil {
+				row["result"] = results[i].Result
+			}
+			row["device"] = t.name
+			row["host"] = results[i].Host
+			row["ok"] = results[i].OK
+			row["error"] = results[i].Error
+			rows = append(rows, row)
+		}
+		out, err = json.Marshal(rows)
+	default:
+		keyed := make(map[string]fanOutResult, len(targets))
+		for i, t := range targets {
+			keyed[t.name] = results[i]
+		}
+		out, err = json.Marshal(keyed)
+	}
+	if err != nil {
+		return err
+	}
+	if err := base.render(out); err != nil {
+		return err
+	}
+	failed := 0
+	for _, r := range results {
+		if !r.OK {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d devices failed", failed, len(targets))
+	}
+	return nil
+}
+
+// fanOutTargets resolves --devices entries as profile names, falling back to
+// treating them as hosts, and --all as every profile in the config file or,
+// when it has none, every device found by discovery.
+func fanOutTargets(ctx context.Context, opts Options, changed func(string) bool) ([]fanOutTarget, error) {
+	cfg, err := LoadConfig(opts.ConfigPath)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, d := range opts.Devices {
+		if d = strings.TrimSpace(d); d != "" {
+			names = append(names, d)
+		}
+	}
+	if opts.All {
+		names = append(names, cfg.DeviceNames()...)
+	}
+	base := opts
+	base.Devices = nil
+	base.All = false
+	targets := []fanOutTarget{}
+	seen := map[string]struct{}{}
+	for _, name := range names {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		o := base
+		if _, ok := cfg.Devices[name]; ok {
+			o.Device = name
+			if err := ApplyProfile(&o, changed); err != nil {
+				return nil, err
+			}
+		} else {
+			o.Device = ""
+			o.Host = name
+			o.BaseURL = ""
+		}
+		targets = append(targets, fanOutTarget{name: name, opts: o})
+	}
+	if opts.All && len(cfg.Devices) == 0 {
+		devs, err := browseMusicCastContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range devs {
+			name := d.Name
+			if _, ok := seen[name]; ok {
+				name = d.Name + " (" + d.Host + ")"
+			}
+			seen[name] = struct{}{}
+			o := base
+			o.Device = ""
+			o.Host = ""
+			o.BaseURL = d.BaseURL
+			targets = append(targets, fanOutTarget{name: name, opts: o})
+		}
+	}
+	sort.SliceStable(targets, func(i, j int) bool { return targets[i].name < targets[j].name })
+	return targets, nil
+}
+
+// decodeOutput turns captured output back into a value: one JSON document,
+// a sequence of them, or plain text.
+func decodeOutput(b []byte) any {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 {
+		return nil
+	}
+	var v any
+	if json.Unmarshal(b, &v) == nil {
+		return v
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	items := []any{}
+	for {
+		var item any
+		err := dec.Decode(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return string(b)
+		}
+		items = append(items, item)
+	}
+	return items
+}
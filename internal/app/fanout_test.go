@@ -0,0 +1,83 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amannm/yxc/pkg/yxc/mock"
+	"github.com/spf13/cobra"
+)
+
+// TestRunFanOut runs against two mock devices, one of which fails, and
+// checks that both are reported, in name order, with the failing device's
+// exit code.
+func TestRunFanOut(t *testing.T) {
+	den, kitchen := mock.New(), mock.New()
+	defer den.Close()
+	defer kitchen.Close()
+	denSrv, kitchenSrv := httptest.NewServer(den), httptest.NewServer(kitchen)
+	defer denSrv.Close()
+	defer kitchenSrv.Close()
+
+	dir := t.TempDir()
+	config := filepath.Join(dir, "config.yaml")
+	data := "devices:\n" +
+		"  kitchen:\n    base_url: " + kitchenSrv.URL + "/YamahaExtendedControl\n" +
+		"  den:\n    base_url: " + denSrv.URL + "/YamahaExtendedControl\n"
+	if err := os.WriteFile(config, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	run := func(format string) (string, error) {
+		// Guarded, exit code 15.
+		kitchen.Fail("main/getStatus", 5)
+		var out bytes.Buffer
+		cmd := &cobra.Command{}
+		cmd.SetOut(&out)
+		cmd.SetContext(context.Background())
+		opts := Options{ConfigPath: config, All: true, Format: format, Timeout: 2 * time.Second}
+		err := Run(cmd, opts, func(a *App) error {
+			return a.show(a.client.Zone("main").GetStatus(cmd.Context()))
+		})
+		return out.String(), err
+	}
+
+	out, err := run("json")
+	if err == nil || err.Error() != "1 of 2 devices failed" || ExitCode(err) != ExitError {
+		t.Errorf("err = %v", err)
+	}
+	var keyed map[string]fanOutResult
+	if err := json.Unmarshal([]byte(out), &keyed); err != nil {
+		t.Fatalf("%v: %s", err, out)
+	}
+	if r := keyed["den"]; !r.OK || r.ExitCode != 0 || r.Result.(map[string]any)["power"] == nil {
+		t.Errorf("den = %+v", r)
+	}
+	if r := keyed["kitchen"]; r.OK || r.ExitCode != 15 || r.Error == "" {
+		t.Errorf("kitchen = %+v", r)
+	}
+
+	out, _ = run("ndjson")
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d rows:\n%s", len(lines), out)
+	}
+	for i, want := range []struct {
+		device string
+		ok     bool
+	}{{"den", true}, {"kitchen", false}} {
+		var row map[string]any
+		if err := json.Unmarshal([]byte(lines[i]), &row); err != nil {
+			t.Fatal(err)
+		}
+		if row["device"] != want.device || row["ok"] != want.ok {
+			t.Errorf("row %d = %v, want device %s ok %v", i, row, want.device, want.ok)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/amannm/yxc/pkg/yxc"
+)
+
+// deviceCache is what a device reported about itself, as cached between
+// runs: getDeviceInfo and getFeatures, and for shell completion its NetUSB
+// presets and the names given to its inputs, which are fetched the first
+// time completion needs them.
+type deviceCache struct {
+	Fetched    time.Time          `json:"fetched"`
+	DeviceInfo yxc.DeviceInfo     `json:"device_info"`
+	Features   json.RawMessage    `json:"features"`
+	NetPresets []yxc.NetUSBPreset `json:"netusb_presets"`
+	NameText   map[string]string  `json:"name_text"`
+	features   *yxc.Features
+}
+
+// model names the device in errors.
+func (f *deviceCache) model() string {
+	if f.DeviceInfo.ModelName != "" {
+		return f.DeviceInfo.ModelName
+	}
+	return "this model"
+}
+
+// hostIndex maps a device's base URL to its device_id, so that a cached
+// entry can be found without asking the device who it is.
+type hostIndex map[string]struct {
+	DeviceID string    `json:"device_id"`
+	Seen     time.Time `json:"seen"`
+}
+
+// DefaultFeaturesDir is where getFeatures results are cached, one file per
+// device_id.
+func DefaultFeaturesDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "yxc", "features")
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// cachedDevice returns the features of the device at host (a scheme and
+// host, e.g. http://192.168.1.50), from the cache while it is younger than
+// --features-ttl and from the device otherwise. With --dry-run or --record
+// only the cache is used, so that no extra requests are sent or recorded;
+// with --replay there are none. A failed fetch is reported on stderr once
+// and returned for the rest of the run.
+func (a *App) cachedDevice(ctx context.Context, host string) (*deviceCache, error) {
+	if a.features != nil || a.featuresErr != nil {
+		return a.features, a.featuresErr
+	}
+	if a.Options.Replay != "" {
+		return nil, nil
+	}
+	dir := a.Options.FeaturesDir
+	ttl := a.Options.FeaturesTTL
+	if dir != "" && ttl > 0 {
+		if f := readCachedFeatures(dir, host, ttl); f != nil {
+			a.features = f
+			return f, nil
+		}
+	}
+	if a.Options.DryRun != "" || a.Options.Record != "" {
+		return nil, nil
+	}
+	f, err := a.fetchDevice(ctx)
+	if err != nil {
+		a.featuresErr = err
+		if !a.Options.Quiet {
+			_, _ = fmt.Fprintf(os.Stderr, "features of %s unavailable, not checking capabilities: %v\n", host, err)
+		}
+		return nil, err
+	}
+	a.features = f
+	a.saveDevice(host, f)
+	return f, nil
+}
+
+// fetchDevice asks the device for getDeviceInfo and getFeatures without
+// retries, so that an unreachable device fails the command's own request
+// instead of delaying it by a round of backoff first.
+func (a *App) fetchDevice(ctx context.Context) (*deviceCache, error) {
+	probe := *a.client
+	probe.Config.Retries = 0
+	probe.Config.Retry.FirmwareWait = 0
+	info, err := probe.System().GetDeviceInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := probe.System().GetFeatures(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceCache{Fetched: time.Now(), DeviceInfo: *info, Features: resp.Raw(), features: resp}, nil
+}
+
+// saveDevice writes f to the cache. The cache only saves requests, so
+// failing to write it is not an error.
+func (a *App) saveDevice(host string, f *deviceCache) {
+	if a.Options.FeaturesDir != "" && f.DeviceInfo.DeviceID != "" {
+		_ = writeCachedFeatures(a.Options.FeaturesDir, host, f)
+	}
+}
+
+func readCachedFeatures(dir, host string, ttl time.Duration) *deviceCache {
+	var index hostIndex
+	if readJSON(filepath.Join(dir, "hosts.json"), &index) != nil {
+		return nil
+	}
+	entry, ok := index[host]
+	if !ok || entry.DeviceID == "" {
+		return nil
+	}
+	f := &deviceCache{}
+	if readJSON(filepath.Join(dir, unsafeFileChars.ReplaceAllString(entry.DeviceID, "_")+".json"), f) != nil {
+		return nil
+	}
+	if time.Since(f.Fetched) > ttl || f.DeviceInfo.DeviceID != entry.DeviceID {
+		return nil
+	}
+	f.features = &yxc.Features{}
+	if json.Unmarshal(f.Features, f.features) != nil {
+		return nil
+	}
+	return f
+}
+
+func writeCachedFeatures(dir, host string, f *deviceCache) error {
+	name := unsafeFileChars.ReplaceAllString(f.DeviceInfo.DeviceID, "_") + ".json"
+	if err := writeJSON(filepath.Join(dir, name), f); err != nil {
+		return err
+	}
+	index := hostIndex{}
+	if err := readJSON(filepath.Join(dir, "hosts.json"), &index); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		index = hostIndex{}
+	}
+	entry := index[host]
+	entry.DeviceID, entry.Seen = f.DeviceInfo.DeviceID, f.Fetched
+	index[host] = entry
+	return writeJSON(filepath.Join(dir, "hosts.json"), index)
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, append(data, '\n'))
+}
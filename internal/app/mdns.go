@@ -0,0 +1,382 @@
+package app
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	dnsTypeA    = 1
+	dnsTypePTR  = 12
+	dnsTypeTXT  = 16
+	dnsTypeAAAA = 28
+	dnsTypeSRV  = 33
+	dnsClassIN  = 1
+
+	mdnsUnicastResponse = 0x8000
+	mdnsCacheFlush      = 0x8000
+)
+
+var mdnsGroup = &net.UDPAddr{IP: net.IPv4(224, 0, 0, 251), Port: 5353}
+
+type dnsQuestion struct {
+	Labels []string
+	Type   uint16
+}
+
+type dnsRecord struct {
+	Name   string
+	Type   uint16
+	Target string
+	Port   int
+	IP     net.IP
+}
+
+type mdnsService struct {
+	Instance string
+	Target   string
+	Port     int
+	Addrs    []net.IP
+}
+
+func browseService(ctx context.Context, service string, timeout time.Duration) ([]discoveredDevice, error) {
+	browse, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	serviceLabels := append(strings.Split(service, "."), "local")
+	records, err := mdnsQuery(browse, []dnsQuestion{{Labels: serviceLabels, Type: dnsTypePTR}})
+	if err != nil {
+		return nil, err
+	}
+	suffix := "." + strings.ToLower(strings.Join(serviceLabels, "."))
+	services := map[string]*mdnsService{}
+	order := []string{}
+	for _, r := range records {
+		if r.Type != dnsTypePTR || strings.ToLower(r.Name) != strings.TrimPrefix(suffix, ".") {
+			continue
+		}
+		if !strings.HasSuffix(strings.ToLower(r.Target), suffix) {
+			continue
+		}
+		instance := r.Target[:len(r.Target)-len(suffix)]
+		key := strings.ToLower(r.Target)
+		if _, ok := services[key]; ok {
+			continue
+		}
+		services[key] = &mdnsService{Instance: instance}
+		order = append(order, key)
+	}
+	if len(services) == 0 {
+		return []discoveredDevice{}, nil
+	}
+	applyRecords(services, records)
+	if missing := unresolvedQuestions(services, serviceLabels); len(missing) > 0 {
+		rctx, rcancel := context.WithTimeout(ctx, 2*time.Second)
+		more, err := mdnsQuery(rctx, missing)
+		rcancel()
+		if err == nil {
+			records = append(records, more...)
+			applyRecords(services, records)
+		}
+	}
+	results := []discoveredDevice{}
+	for _, key := range order {
+		s := services[key]
+		if s.Target == "" {
+			continue
+		}
+		results = append(results, s.device(service))
+	}
+	return results, nil
+}
+
+func (s *mdnsService) device(service string) discoveredDevice {
+	host := strings.TrimSuffix(s.Target, ".")
+	addrs := []string{}
+	urlHost := host
+	for _, ip := range s.Addrs {
+		addrs = append(addrs, ip.String())
+		if urlHost == host && ip.To4() != nil {
+			urlHost = ip.String()
+		}
+	}
+	return discoveredDevice{
+		Name:      s.Instance,
+		Type:      service,
+		Domain:    "local",
+		Host:      host,
+		Port:      s.Port,
+		Addresses: addrs,
+		BaseURL:   deviceBaseURL(urlHost, s.Port),
+		Source:    "mdns",
+	}
+}
+
+func applyRecords(services map[string]*mdnsService, records []dnsRecord) {
+	addrs := map[string][]net.IP{}
+	for _, r := range records {
+		if r.Type == dnsTypeA || r.Type == dnsTypeAAAA {
+			key := strings.ToLower(r.Name)
+			dup := false
+			for _, ip := range addrs[key] {
+				if ip.Equal(r.IP) {
+					dup = true
+					break
+				}
+			}
+			if !dup {
+				addrs[key] = append(addrs[key], r.IP)
+			}
+		}
+	}
+	for _, r := range records {
+		if r.Type != dnsTypeSRV {
+			continue
+		}
+		if s, ok := services[strings.ToLower(r.Name)]; ok && s.Target == "" {
+			s.Target = r.Target
+			s.Port = r.Port
+		}
+	}
+	for _, s := range services {
+		if s.Target != "" {
+			s.Addrs = addrs[strings.ToLower(s.Target)]
+		}
+	}
+}
+
+func unresolvedQuestions(services map[string]*mdnsService, serviceLabels []string) []dnsQuestion {
+	qs := []dnsQuestion{}
+	for _, s := range services {
+		switch {
+		case s.Target == "":
+			labels := append([]string{s.Instance}, serviceLabels...)
+			qs = append(qs, dnsQuestion{Labels: labels, Type: dnsTypeSRV})
+		case len(s.Addrs) == 0:
+			labels := strings.Split(strings.TrimSuffix(s.Target, "."), ".")
+			qs = append(qs, dnsQuestion{Labels: labels, Type: dnsTypeA})
+		}
+	}
+	return qs
+}
+
+func mdnsQuery(ctx context.Context, questions []dnsQuestion) ([]dnsRecord, error) {
+	msg, err := encodeDNSQuery(uint16(rand.UintN(0xffff)+1), questions)
+	if err != nil {
+		return nil, err
+	}
+	conns, err := multicastConns()
+	if err != nil {
+		return nil, err
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	records := []dnsRecord{}
+	sent := 0
+	for _, conn := range conns {
+		if _, err := conn.WriteToUDP(msg, mdnsGroup); err != nil {
+			_ = conn.Close()
+			continue
+		}
+		sent++
+		wg.Add(1)
+		go func(conn *net.UDPConn) {
+			defer wg.Done()
+			defer conn.Close()
+			readPackets(ctx, conn, func(pkt []byte, _ *net.UDPAddr) {
+				rs, err := decodeDNSRecords(pkt)
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				records = append(records, rs...)
+				mu.Unlock()
+			})
+		}(conn)
+	}
+	if sent == 0 {
+		return nil, errors.New("mdns: no interface accepted the query")
+	}
+	wg.Wait()
+	return records, nil
+}
+
+func multicastConns() ([]*net.UDPConn, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	conns := []*net.UDPConn{}
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagUp == 0 || iface.Flags&net.FlagMulticast == 0 || iface.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+		addrs, err := iface.Addrs()
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			ipnet, ok := addr.(*net.IPNet)
+			if !ok || ipnet.IP.To4() == nil {
+				continue
+			}
+			conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: ipnet.IP})
+			if err == nil {
+				conns = append(conns, conn)
+			}
+		}
+	}
+	if len(conns) == 0 {
+		conn, err := net.ListenUDP("udp4", &net.UDPAddr{})
+		if err != nil {
+			return nil, err
+		}
+		conns = append(conns, conn)
+	}
+	return conns, nil
+}
+
+func readPackets(ctx context.Context, conn *net.UDPConn, handle func([]byte, *net.UDPAddr)) {
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetReadDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetReadDeadline(time.Now())
+	})
+	defer stop()
+	buf := make([]byte, 9000)
+	for {
+		n, from, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		pkt := make([]byte, n)
+		copy(pkt, buf[:n])
+		handle(pkt, from)
+	}
+}
+
+func encodeDNSQuery(id uint16, questions []dnsQuestion) ([]byte, error) {
+	msg := make([]byte, 12, 512)
+	binary.BigEndian.PutUint16(msg[0:], id)
+	binary.BigEndian.PutUint16(msg[4:], uint16(len(questions)))
+	for _, q := range questions {
+		for _, label := range q.Labels {
+			if len(label) == 0 || len(label) > 63 {
+				return nil, errors.New("dns: invalid label " + label)
+			}
+			msg = append(msg, byte(len(label)))
+			msg = append(msg, label...)
+		}
+		msg = append(msg, 0)
+		msg = binary.BigEndian.AppendUint16(msg, q.Type)
+		msg = binary.BigEndian.AppendUint16(msg, dnsClassIN|mdnsUnicastResponse)
+	}
+	return msg, nil
+}
+
+func decodeDNSRecords(msg []byte) ([]dnsRecord, error) {
+	if len(msg) < 12 {
+		return nil, errors.New("dns: short message")
+	}
+	if msg[2]&0x80 == 0 {
+		return nil, errors.New("dns: not a response")
+	}
+	qd := int(binary.BigEndian.Uint16(msg[4:]))
+	rr := int(binary.BigEndian.Uint16(msg[6:])) + int(binary.BigEndian.Uint16(msg[8:])) + int(binary.BigEndian.Uint16(msg[10:]))
+	off := 12
+	for i := 0; i < qd; i++ {
+		_, next, err := readDNSName(msg, off)
+		if err != nil {
+			return nil, err
+		}
+		off = next + 4
+	}
+	records := []dnsRecord{}
+	for i := 0; i < rr; i++ {
+		name, next, err := readDNSName(msg, off)
+		if err != nil {
+			return records, err
+		}
+		if next+10 > len(msg) {
+			return records, errors.New("dns: truncated record")
+		}
+		typ := binary.BigEndian.Uint16(msg[next:])
+		class := binary.BigEndian.Uint16(msg[next+2:]) &^ mdnsCacheFlush
+		rdlen := int(binary.BigEndian.Uint16(msg[next+8:]))
+		rdata := next + 10
+		off = rdata + rdlen
+		if off > len(msg) {
+			return records, errors.New("dns: truncated rdata")
+		}
+		if class != dnsClassIN {
+			continue
+		}
+		r := dnsRecord{Name: name, Type: typ}
+		switch typ {
+		case dnsTypePTR:
+			if r.Target, _, err = readDNSName(msg, rdata); err != nil {
+				continue
+			}
+		case dnsTypeSRV:
+			if rdlen < 7 {
+				continue
+			}
+			r.Port = int(binary.BigEndian.Uint16(msg[rdata+4:]))
+			if r.Target, _, err = readDNSName(msg, rdata+6); err != nil {
+				continue
+			}
+		case dnsTypeA:
+			if rdlen != net.IPv4len {
+				continue
+			}
+			r.IP = net.IP(append([]byte(nil), msg[rdata:rdata+rdlen]...))
+		case dnsTypeAAAA:
+			if rdlen != net.IPv6len {
+				continue
+			}
+			r.IP = net.IP(append([]byte(nil), msg[rdata:rdata+rdlen]...))
+		default:
+			continue
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+func readDNSName(msg []byte, off int) (string, int, error) {
+	labels := []string{}
+	next := -1
+	for hops := 0; ; hops++ {
+		if off >= len(msg) || hops > 64 {
+			return "", 0, errors.New("dns: invalid name")
+		}
+		n := int(msg[off])
+		switch {
+		case n == 0:
+			if next < 0 {
+				next = off + 1
+			}
+			return strings.Join(labels, "."), next, nil
+		case n&0xc0 == 0xc0:
+			if off+1 >= len(msg) {
+				return "", 0, errors.New("dns: invalid pointer")
+			}
+			if next < 0 {
+				next = off + 2
+			}
+			off = int(binary.BigEndian.Uint16(msg[off:]) & 0x3fff)
+		default:
+			if off+1+n > len(msg) {
+				return "", 0, errors.New("dns: invalid label")
+			}
+			labels = append(labels, string(msg[off+1:off+1+n]))
+			off += 1 + n
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"encoding/hex"
+	"net"
+	"reflect"
+	"testing"
+)
+
+// browseResponse answers a PTR query for _http._tcp.local the way a
+// MusicCast device does: the PTR answer, with TXT, SRV (cache-flush), A and
+// AAAA records for the instance as additionals, every repeated name
+// compressed.
+var browseResponse = mustHex("" +
+	"000084000000000100000004055f68747470045f746370056c6f63616c00000c" +
+	"000100001194000e0b4c6976696e6720526f6f6dc00cc0280010800100001194" +
+	"00150c6d6f64656c3d52582d5636410769643d31323334c02800218001000000" +
+	"7800140000000000500b52582d5636412d31323334c017c06900018001000000" +
+	"780004c0a80132c069001c8001000000780010fe800000000000000000000000" +
+	"000001")
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestDecodeDNSRecords(t *testing.T) {
+	got, err := decodeDNSRecords(browseResponse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The TXT record is skipped: discovery reads nothing from it.
+	want := []dnsRecord{
+		{Name: "_http._tcp.local", Type: dnsTypePTR, Target: "Living Room._http._tcp.local"},
+		{Name: "Living Room._http._tcp.local", Type: dnsTypeSRV, Target: "RX-V6A-1234.local", Port: 80},
+		{Name: "RX-V6A-1234.local", Type: dnsTypeA, IP: net.IPv4(192, 168, 1, 50).To4()},
+		{Name: "RX-V6A-1234.local", Type: dnsTypeAAAA, IP: net.ParseIP("fe80::1")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got  %+v\nwant %+v", got, want)
+	}
+
+	services := map[string]*mdnsService{"living room._http._tcp.local": {Instance: "Living Room"}}
+	applyRecords(services, got)
+	d := services["living room._http._tcp.local"].device("_http._tcp")
+	if d.Host != "RX-V6A-1234.local" || d.Port != 80 || d.BaseURL != "http://192.168.1.50/YamahaExtendedControl" {
+		t.Errorf("device = %+v", d)
+	}
+	if len(d.Addresses) != 2 {
+		t.Errorf("addresses = %v", d.Addresses)
+	}
+}
+
+func TestDecodeDNSRecordsMalformed(t *testing.T) {
+	for n := range len(browseResponse) {
+		if _, err := decodeDNSRecords(browseResponse[:n]); err == nil {
+			t.Errorf("truncated to %d bytes: no error", n)
+		}
+	}
+	query := append([]byte(nil), browseResponse...)
+	query[2] = 0
+	if _, err := decodeDNSRecords(query); err == nil {
+		t.Error("query decoded as a response")
+	}
+	// A name whose compression pointer points at itself.
+	loop := mustHex("000084000000000100000000c00c000c0001000000780002c00c")
+	if _, err := decodeDNSRecords(loop); err == nil {
+		t.Error("pointer loop decoded")
+	}
+	for _, tc := range []struct {
+		msg  string
+		off  int
+		name string
+	}{
+		{"0361626300", 0, "abc"},
+		{"03616263c000", 0, ""},
+		{"0361626300c000", 5, "abc"},
+		{"05616263", 0, ""},
+		{"c0", 0, ""},
+	} {
+		name, _, err := readDNSName(mustHex(tc.msg), tc.off)
+		if (err == nil) != (tc.name != "") || name != tc.name {
+			t.Errorf("readDNSName(%s, %d) = %q, %v", tc.msg, tc.off, name, err)
+		}
+	}
+}
+
+func TestEncodeDNSQuery(t *testing.T) {
+	got, err := encodeDNSQuery(0x1234, []dnsQuestion{{Labels: []string{"_http", "_tcp", "local"}, Type: dnsTypePTR}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// One question, asking for a unicast response.
+	want := mustHex("123400000001000000000000055f68747470045f746370056c6f63616c00000c8001")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+	if _, err := encodeDNSQuery(1, []dnsQuestion{{Labels: []string{"a", "", "local"}, Type: dnsTypeA}}); err == nil {
+		t.Error("empty label encoded")
+	}
+}
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/amannm/yxc/pkg/yxc/mock"
+	"github.com/spf13/cobra"
+)
+
+// MockServer serves an in-memory device until the process is stopped. Point
+// other commands at it with --base-url.
+func (a *App) MockServer(cmd *cobra.Command) error {
+	listen, err := cmd.Flags().GetString("listen")
+	if err != nil {
+		return err
+	}
+	ln, err := net.Listen("tcp", listen)
+	if err != nil {
+		return fmt.Errorf("mock-server: %w", err)
+	}
+	d := mock.New()
+	defer d.Close()
+	if !a.Options.Quiet {
+		_, _ = fmt.Fprintf(os.Stderr, "mock device %s serving on http://%s/YamahaExtendedControl\n", d.DeviceID(), ln.Addr())
+	}
+	srv := &http.Server{Handler: d}
+	stop := context.AfterFunc(cmd.Context(), func() {
+		_ = srv.Close()
+	})
+	defer stop()
+	if err := srv.Serve(ln); err != nil && cmd.Context().Err() == nil {
+		return fmt.Errorf("mock-server: %w", err)
+	}
+	return nil
+}
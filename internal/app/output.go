@@ -4,8 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"os"
-	"sort"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -18,101 +16,95 @@ func (a *App) render(body []byte) error {
 	format := strings.ToLower(strings.TrimSpace(a.Options.Format))
 	var v any
 	if err := json.Unmarshal(body, &v); err != nil {
-		_, werr := os.Stdout.Write(body)
+		_, werr := a.out.Write(body)
 		if werr == nil && !bytes.HasSuffix(body, []byte("\n")) {
-			_, _ = fmt.Fprintln(os.Stdout)
+			_, _ = fmt.Fprintln(a.out)
 		}
 		return werr
 	}
+	if len(a.Options.Fields) > 0 {
+		return a.renderFields(v)
+	}
+	if text, ok := templateText(a.Options.Format); ok {
+		return a.renderTemplate(text, v)
+	}
 	switch format {
 	case "json":
 		out, err := json.Marshal(v)
 		if err != nil {
 			return err
 		}
-		_, err = os.Stdout.Write(append(out, '\n'))
+		_, err = a.out.Write(append(out, '\n'))
 		return err
 	case "yaml":
 		out, err := yaml.Marshal(v)
 		if err != nil {
 			return err
 		}
-		_, err = os.Stdout.Write(out)
+		_, err = a.out.Write(out)
 		return err
 	case "table":
-		out := renderTable(v)
-		_, err := os.Stdout.WriteString(out)
-		return err
+		return a.renderTable(v)
+	case "csv":
+		return a.renderCSV(v)
+	case "ndjson":
+		return a.renderNDJSON(v)
 	default:
 		out, err := json.MarshalIndent(v, "", "  ")
 		if err != nil {
 			return err
 		}
-		_, err = os.Stdout.Write(append(out, '\n'))
+		_, err = a.out.Write(append(out, '\n'))
 		return err
 	}
 }
 
-func renderTable(v any) string {
-	var b strings.Builder
-	switch t := v.(type) {
-	case map[string]any:
-		keys := make([]string, 0, len(t))
-		for k := range t {
-			keys = append(keys, k)
-		}
-		sort.Strings(keys)
-		for _, k := range keys {
-			b.WriteString(k)
-			b.WriteString("\t")
-			b.WriteString(valueString(t[k]))
-			b.WriteString("\n")
+// renderFields prints the values selected by --field, one per line. Strings,
+// numbers and booleans print bare so shell scripts can use them directly;
+// objects and lists are rendered in the output format.
+func (a *App) renderFields(v any) error {
+	plain := *a
+	plain.Options.Fields = nil
+	for _, expr := range a.Options.Fields {
+		steps, err := parsePath(expr)
+		if err != nil {
+			return err
 		}
-	case []any:
-		if len(t) == 0 {
-			return ""
+		sel, ok := queryPath(v, steps)
+		if !ok {
+			return fmt.Errorf("field %s: no such value", expr)
 		}
-		allMaps := true
-		keySet := map[string]struct{}{}
-		for _, item := range t {
-			m, ok := item.(map[string]any)
-			if !ok {
-				allMaps = false
-				break
+		switch sel.(type) {
+		case map[string]any, []any:
+			if err := plain.renderValue(sel); err != nil {
+				return err
 			}
-			for k := range m {
-				keySet[k] = struct{}{}
-			}
-		}
-		if allMaps {
-			keys := make([]string, 0, len(keySet))
-			for k := range keySet {
-				keys = append(keys, k)
+		default:
+			if sel == nil {
+				sel = "null"
 			}
-			sort.Strings(keys)
-			b.WriteString(strings.Join(keys, "\t"))
-			b.WriteString("\n")
-			for _, item := range t {
-				m := item.(map[string]any)
-				for i, k := range keys {
-					if i > 0 {
-						b.WriteString("\t")
-					}
-					b.WriteString(valueString(m[k]))
-				}
-				b.WriteString("\n")
+			if _, err := fmt.Fprintln(a.out, valueString(sel)); err != nil {
+				return err
 			}
-			return b.String()
 		}
-		for _, item := range t {
-			b.WriteString(valueString(item))
-			b.WriteString("\n")
+	}
+	return nil
+}
+
+// CheckOutput rejects a malformed --format template or --field path before
+// any request is sent.
+func CheckOutput(opts Options) error {
+	if text, ok := templateText(opts.Format); ok {
+		if _, err := parseTemplate(text); err != nil {
+			return err
+		}
+	}
+	for _, expr := range opts.Fields {
+		if _, err := parsePath(expr); err != nil {
+			return err
 		}
-	default:
-		b.WriteString(valueString(t))
-		b.WriteString("\n")
 	}
-	return b.String()
+	return nil
 }
 
 func valueString(v any) string {
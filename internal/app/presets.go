@@ -0,0 +1,251 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// VolumePresets maps device -> zone -> preset name -> volume.
+type VolumePresets map[string]map[string]map[string]int
+
+type volumePreset struct {
+	Device string `json:"device"`
+	Zone   string `json:"zone"`
+	Name   string `json:"name"`
+	Volume int    `json:"volume"`
+}
+
+func (a *App) presetsPath() string {
+	if a.Options.ConfigPath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(a.Options.ConfigPath), "presets.yaml")
+}
+
+// presetScope names the device presets are stored under: the profile name
+// when --device is used, otherwise the host or base URL.
+func (a *App) presetScope() (string, string) {
+	device := strings.TrimSpace(a.Options.Device)
+	if device == "" {
+		device = strings.TrimSpace(a.Options.Host)
+	}
+	if device == "" {
+		device = strings.TrimSpace(a.Options.BaseURL)
+	}
+	if device == "" {
+		device = "default"
+	}
+	return device, zoneOrDefault(a.Options.Zone)
+}
+
+func loadPresets(path string) (VolumePresets, error) {
+	presets := VolumePresets{}
+	if path == "" {
+		return presets, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return presets, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, &presets); err != nil {
+		return nil, fmt.Errorf("presets %s: %w", path, err)
+	}
+	return presets, nil
+}
+
+func (p VolumePresets) save(path string) error {
+	if path == "" {
+		return errors.New("presets: no presets path")
+	}
+	data, err := yaml.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, data)
+}
+
+func (p VolumePresets) set(device, zone, name string, volume int) {
+	if p[device] == nil {
+		p[device] = map[string]map[string]int{}
+	}
+	if p[device][zone] == nil {
+		p[device][zone] = map[string]int{}
+	}
+	p[device][zone][name] = volume
+}
+
+// checkPreset validates a preset name and volume. Names must not be
+// mistaken for the other values zone volume accepts.
+func checkPreset(name, volume string) (string, int, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || name == "up" || name == "down" {
+		return "", 0, fmt.Errorf("invalid name %q", name)
+	}
+	if _, err := strconv.Atoi(name); err == nil {
+		return "", 0, fmt.Errorf("name %s must not be a number", name)
+	}
+	if _, ok := parseVolumeLevel(name); ok {
+		return "", 0, fmt.Errorf("name %s must not be a volume in dB or percent", name)
+	}
+	v, err := strconv.Atoi(strings.TrimSpace(volume))
+	if err != nil || v < 0 {
+		return "", 0, fmt.Errorf("invalid volume %s", volume)
+	}
+	return name, v, nil
+}
+
+// parsePresetLines reads presets written one per line as "name = volume",
+// the format of the settings file presets replace. Blank lines and lines
+// starting with # are skipped, and a period after the volume is tolerated.
+func parsePresetLines(data []byte) (map[string]int, error) {
+	out := map[string]int{}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, volume, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: want name = volume", i+1)
+		}
+		n, v, err := checkPreset(name, strings.TrimSuffix(strings.TrimSpace(volume), "."))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		out[n] = v
+	}
+	return out, nil
+}
+
+func (p VolumePresets) lookup(device, zone, name string) (int, bool) {
+	v, ok := p[device][zone][name]
+	return v, ok
+}
+
+func (p VolumePresets) list(device, zone string) []volumePreset {
+	items := []volumePreset{}
+	for d, zones := range p {
+		if device != "" && d != device {
+			continue
+		}
+		for z, names := range zones {
+			if zone != "" && z != zone {
+				continue
+			}
+			for name, v := range names {
+				items = append(items, volumePreset{Device: d, Zone: z, Name: name, Volume: v})
+			}
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Device != items[j].Device {
+			return items[i].Device < items[j].Device
+		}
+		if items[i].Zone != items[j].Zone {
+			return items[i].Zone < items[j].Zone
+		}
+		return items[i].Name < items[j].Name
+	})
+	return items
+}
+
+func (a *App) resolveVolumePreset(name string) (int, bool, error) {
+	presets, err := loadPresets(a.presetsPath())
+	if err != nil {
+		return 0, false, err
+	}
+	device, zone := a.presetScope()
+	v, ok := presets.lookup(device, zone, name)
+	return v, ok, nil
+}
+
+func (a *App) Presets(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("presets: missing subcommand")
+	}
+	path := a.presetsPath()
+	presets, err := loadPresets(path)
+	if err != nil {
+		return err
+	}
+	device, zone := a.presetScope()
+	switch args[0] {
+	case "list":
+		all, err := cmd.Flags().GetBool("all-zones")
+		if err != nil {
+			return err
+		}
+		if all {
+			return a.renderValue(presets.list("", ""))
+		}
+		return a.renderValue(presets.list(device, zone))
+	case "set":
+		if len(args) < 3 {
+			return fmt.Errorf("presets set: requires name and volume")
+		}
+		name, volume, err := checkPreset(args[1], args[2])
+		if err != nil {
+			return fmt.Errorf("presets set: %w", err)
+		}
+		presets.set(device, zone, name, volume)
+		if err := presets.save(path); err != nil {
+			return err
+		}
+		return a.renderValue(volumePreset{Device: device, Zone: zone, Name: name, Volume: volume})
+	case "import":
+		if len(args) < 2 {
+			return fmt.Errorf("presets import: missing file")
+		}
+		data, err := os.ReadFile(args[1])
+		if err != nil {
+			return fmt.Errorf("presets import: %w", err)
+		}
+		imported, err := parsePresetLines(data)
+		if err != nil {
+			return fmt.Errorf("presets import: %s: %w", args[1], err)
+		}
+		for name, volume := range imported {
+			presets.set(device, zone, name, volume)
+		}
+		if err := presets.save(path); err != nil {
+			return err
+		}
+		items := VolumePresets{device: {zone: imported}}
+		return a.renderValue(items.list(device, zone))
+	case "delete":
+		if len(args) < 2 {
+			return fmt.Errorf("presets delete: missing name")
+		}
+		if _, ok := presets.lookup(device, zone, args[1]); !ok {
+			return fmt.Errorf("presets delete: no preset %s for %s/%s", args[1], device, zone)
+		}
+		delete(presets[device][zone], args[1])
+		if len(presets[device][zone]) == 0 {
+			delete(presets[device], zone)
+		}
+		if len(presets[device]) == 0 {
+			delete(presets, device)
+		}
+		if err := presets.save(path); err != nil {
+			return err
+		}
+		if !a.Options.Quiet {
+			_, _ = fmt.Fprintf(os.Stderr, "deleted preset %s for %s/%s\n", args[1], device, zone)
+		}
+		return nil
+	default:
+		return fmt.Errorf("presets: unknown command %s", args[0])
+	}
+}
@@ -0,0 +1,21 @@
+package app
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestParsePresetLines(t *testing.T) {
+	got, err := parsePresetLines([]byte("# volumes\noff = 55\n\nfridge-on = 75.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]int{"off": 55, "fridge-on": 75}; !maps.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	for _, bad := range []string{"off 55", "up = 10", "40 = 10", "loud = high", "-3dB = 10"} {
+		if _, err := parsePresetLines([]byte(bad)); err == nil {
+			t.Errorf("%q accepted", bad)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A field path selects values from a decoded JSON response, in the spirit of
+// jq and JMESPath:
+//
+//	volume                  key
+//	zone[0].id, zone.0.id   array index (negative counts from the end)
+//	zone[*].id, zone.*.id   every element of an array, or value of an object
+//	zone[?id==main].volume  elements whose field matches (== or !=)
+//
+// A leading "." is optional. After a wildcard or filter the rest of the path
+// is applied to each element and elements without a value are dropped, so the
+// result is always a list.
+type pathStep struct {
+	kind  stepKind
+	key   string
+	index int
+	op    string
+	value string
+}
+
+type stepKind int
+
+const (
+	stepKey stepKind = iota
+	stepIndex
+	stepWildcard
+	stepFilter
+)
+
+func parsePath(expr string) ([]pathStep, error) {
+	src := strings.TrimSpace(expr)
+	s := strings.TrimPrefix(src, ".")
+	steps := []pathStep{}
+	for s != "" {
+		switch {
+		case s[0] == '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("field %s: unclosed [", src)
+			}
+			step, err := parseBracket(s[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", src, err)
+			}
+			steps = append(steps, step)
+			s = s[end+1:]
+		case s[0] == '.':
+			s = s[1:]
+			if s == "" || s[0] == '.' || s[0] == '[' {
+				return nil, fmt.Errorf("field %s: empty key", src)
+			}
+		default:
+			end := strings.IndexAny(s, ".[")
+			if end < 0 {
+				end = len(s)
+			}
+			steps = append(steps, keyStep(s[:end]))
+			s = s[end:]
+		}
+	}
+	return steps, nil
+}
+
+func keyStep(key string) pathStep {
+	if key == "*" {
+		return pathStep{kind: stepWildcard}
+	}
+	if n, err := strconv.Atoi(key); err == nil {
+		return pathStep{kind: stepIndex, key: key, index: n}
+	}
+	return pathStep{kind: stepKey, key: key}
+}
+
+func parseBracket(in string) (pathStep, error) {
+	in = strings.TrimSpace(in)
+	switch {
+	case in == "*" || in == "":
+		return pathStep{kind: stepWildcard}, nil
+	case strings.HasPrefix(in, "?"):
+		cond := in[1:]
+		for _, op := range []string{"==", "!="} {
+			if k, v, ok := strings.Cut(cond, op); ok {
+				v = strings.TrimSpace(v)
+				if uq, err := strconv.Unquote(v); err == nil {
+					v = uq
+				} else {
+					v = strings.Trim(v, "'")
+				}
+				return pathStep{kind: stepFilter, key: strings.TrimSpace(k), op: op, value: v}, nil
+			}
+		}
+		return pathStep{}, fmt.Errorf("filter [%s] needs == or !=", in)
+	default:
+		n, err := strconv.Atoi(in)
+		if err != nil {
+			if uq, qerr := strconv.Unquote(in); qerr == nil {
+				return pathStep{kind: stepKey, key: uq}, nil
+			}
+			return pathStep{}, fmt.Errorf("invalid index [%s]", in)
+		}
+		return pathStep{kind: stepIndex, key: in, index: n}, nil
+	}
+}
+
+// queryPath applies a parsed field path to v.
+func queryPath(v any, steps []pathStep) (any, bool) {
+	if len(steps) == 0 {
+		return v, true
+	}
+	step, rest := steps[0], steps[1:]
+	switch step.kind {
+	case stepKey:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		child, ok := m[step.key]
+		if !ok {
+			return nil, false
+		}
+		return queryPath(child, rest)
+	case stepIndex:
+		switch t := v.(type) {
+		case []any:
+			i := step.index
+			if i < 0 {
+				i += len(t)
+			}
+			if i < 0 || i >= len(t) {
+				return nil, false
+			}
+			return queryPath(t[i], rest)
+		case map[string]any:
+			// Numeric keys, e.g. preset numbers.
+			child, ok := t[step.key]
+			if !ok {
+				return nil, false
+			}
+			return queryPath(child, rest)
+		}
+		return nil, false
+	case stepWildcard:
+		return project(elements(v), rest)
+	case stepFilter:
+		items, ok := v.([]any)
+		if !ok {
+			return nil, false
+		}
+		matched := []any{}
+		for _, item := range items {
+			m, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			field, present := m[step.key]
+			equal := present && valueString(field) == step.value
+			if equal == (step.op == "==") {
+				matched = append(matched, item)
+			}
+		}
+		return project(matched, rest)
+	}
+	return nil, false
+}
+
+func elements(v any) []any {
+	switch t := v.(type) {
+	case []any:
+		return t
+	case map[string]any:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		out := make([]any, len(keys))
+		for i, k := range keys {
+			out[i] = t[k]
+		}
+		return out
+	}
+	return nil
+}
+
+func project(items []any, rest []pathStep) (any, bool) {
+	out := []any{}
+	for _, item := range items {
+		if r, ok := queryPath(item, rest); ok {
+			out = append(out, r)
+		}
+	}
+	return out, true
+}
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestQueryPath(t *testing.T) {
+	var v any
+	doc := `{"volume":60,"zone":[{"id":"main","volume":60},{"id":"zone2","volume":20}],"preset":{"1":{"text":"a"}}}`
+	if err := json.Unmarshal([]byte(doc), &v); err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string]string{
+		".volume":                `60`,
+		"zone[1].id":             `"zone2"`,
+		"zone.-1.volume":         `20`,
+		"zone[*].id":             `["main","zone2"]`,
+		"zone[?id==main].volume": `[60]`,
+		"zone[?id!=main].id":     `["zone2"]`,
+		"preset.1.text":          `"a"`,
+		`preset["1"].text`:       `"a"`,
+		"zone[*].missing":        `[]`,
+	}
+	for expr, want := range cases {
+		steps, err := parsePath(expr)
+		if err != nil {
+			t.Fatalf("%s: %v", expr, err)
+		}
+		got, ok := queryPath(v, steps)
+		if !ok {
+			t.Fatalf("%s: no value", expr)
+		}
+		out, _ := json.Marshal(got)
+		if string(out) != want {
+			t.Errorf("%s = %s, want %s", expr, out, want)
+		}
+	}
+	for _, expr := range []string{"zone[", "zone[x]", "zone[?id]", "a..b"} {
+		if _, err := parsePath(expr); err == nil {
+			t.Errorf("%s: expected parse error", expr)
+		}
+	}
+	steps, _ := parsePath("nope")
+	if _, ok := queryPath(v, steps); ok {
+		t.Error("nope: expected no value")
+	}
+}
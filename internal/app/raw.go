@@ -51,5 +51,5 @@ func (a *App) Raw(cmd *cobra.Command, args []string) error {
 			body = enc
 		}
 	}
-	return a.call(method, path, q, body, "application/json")
+	return a.call(cmd.Context(), method, path, q, body, "application/json")
 }
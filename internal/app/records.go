@@ -0,0 +1,111 @@
+package app
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// renderNDJSON prints each element of a list as one line of compact JSON,
+// and anything else as a single line. Streaming commands render every record
+// separately, so they emit one line per record.
+func (a *App) renderNDJSON(v any) error {
+	items, ok := v.([]any)
+	if !ok {
+		items = []any{v}
+	}
+	for _, item := range items {
+		out, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		if _, err := a.out.Write(append(out, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderCSV prints a list as one row per element and an object as a single
+// row, under a header of dotted column names (sorted, or as given by
+// --columns). Nested lists of objects are kept as JSON in their cell. The
+// header is written once for consecutive records with the same columns, so
+// streamed records form a single CSV document.
+func (a *App) renderCSV(v any) error {
+	var records []map[string]any
+	switch t := v.(type) {
+	case []any:
+		for _, item := range t {
+			records = append(records, flattenRecord(item))
+		}
+	case map[string]any:
+		records = append(records, flattenRecord(t))
+	default:
+		w := csv.NewWriter(a.out)
+		_ = w.Write([]string{valueString(t)})
+		w.Flush()
+		return w.Error()
+	}
+	header := slices.Clone(a.Options.Columns)
+	if len(header) == 0 {
+		seen := map[string]bool{}
+		for _, r := range records {
+			for k := range r {
+				if !seen[k] {
+					seen[k] = true
+					header = append(header, k)
+				}
+			}
+		}
+		sort.Strings(header)
+	}
+	if col, desc := strings.CutPrefix(strings.TrimSpace(a.Options.SortBy), "-"); col != "" {
+		sort.SliceStable(records, func(x, y int) bool {
+			l, r := records[x][col], records[y][col]
+			if desc {
+				l, r = r, l
+			}
+			return lessValue(l, r)
+		})
+	}
+	w := csv.NewWriter(a.out)
+	if !slices.Equal(header, a.csvHeader) {
+		if err := w.Write(header); err != nil {
+			return err
+		}
+		a.csvHeader = header
+	}
+	for _, r := range records {
+		row := make([]string, len(header))
+		for i, k := range header {
+			if val, ok := r[k]; ok && val != nil {
+				row[i] = cellText(val)
+			}
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// flattenRecord turns one list element into dotted columns. A scalar element
+// becomes the single column "value".
+func flattenRecord(v any) map[string]any {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return map[string]any{"value": v}
+	}
+	out := map[string]any{}
+	flattenRow(out, "", m, func(key string, list []map[string]any) {
+		items := make([]any, len(list))
+		for i, item := range list {
+			items[i] = item
+		}
+		out[key] = items
+	})
+	return out
+}
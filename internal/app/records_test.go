@@ -0,0 +1,81 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+)
+
+func renderString(t *testing.T, opts Options, bodies ...string) string {
+	t.Helper()
+	var out bytes.Buffer
+	a := New(opts)
+	a.out = &out
+	for _, body := range bodies {
+		if err := a.render([]byte(body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return out.String()
+}
+
+func TestRenderCSV(t *testing.T) {
+	list := `[
+		{"id":"main","text":"Living Room, \"big\"","tone":{"bass":-2,"treble":1},"inputs":["hdmi1","tuner"]},
+		{"id":"zone2","text":"line\nbreak","tone":{"bass":0},"presets":[{"num":1},{"num":2}]}
+	]`
+	for _, tc := range []struct {
+		name string
+		opts Options
+		in   []string
+		want string
+	}{
+		{
+			name: "list",
+			in:   []string{list},
+			want: "id,inputs,presets,text,tone.bass,tone.treble\n" +
+				"main,\"hdmi1, tuner\",,\"Living Room, \"\"big\"\"\",-2,1\n" +
+				"zone2,,\"[{\"\"num\"\":1},{\"\"num\"\":2}]\",\"line\nbreak\",0,\n",
+		},
+		{
+			name: "columns and sort",
+			opts: Options{Columns: []string{"id", "tone.bass"}, SortBy: "-tone.bass"},
+			in:   []string{list},
+			want: "id,tone.bass\nzone2,0\nmain,-2\n",
+		},
+		{
+			name: "object",
+			in:   []string{`{"power":"on","volume":40}`},
+			want: "power,volume\non,40\n",
+		},
+		{
+			name: "scalars",
+			in:   []string{`[1,"two",null]`},
+			want: "value\n1\ntwo\n\n",
+		},
+		{
+			name: "streamed records share one header",
+			in:   []string{`{"path":"volume","new":41}`, `{"path":"volume","new":42}`, `{"path":"mute","new":true,"old":false}`},
+			want: "new,path\n41,volume\n42,volume\nnew,old,path\ntrue,false,mute\n",
+		},
+	} {
+		tc.opts.Format = "csv"
+		if got := renderString(t, tc.opts, tc.in...); got != tc.want {
+			t.Errorf("%s:\n%s\nwant:\n%s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestRenderNDJSON(t *testing.T) {
+	for _, tc := range []struct {
+		name, in, want string
+	}{
+		{"list", `[{"id":"main","tone":{"bass":-2}}, ["a","b"], 3]`, "{\"id\":\"main\",\"tone\":{\"bass\":-2}}\n[\"a\",\"b\"]\n3\n"},
+		{"object", "{\n  \"power\": \"on\",\n  \"text\": \"a\\nb\"\n}", "{\"power\":\"on\",\"text\":\"a\\nb\"}\n"},
+		{"empty list", `[]`, ""},
+		{"scalar", `"standby"`, "\"standby\"\n"},
+	} {
+		if got := renderString(t, Options{Format: "ndjson"}, tc.in); got != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/amannm/yxc/pkg/yxc"
+)
+
+func (a *App) newClient() *yxc.Client {
+	baseURL := a.Options.BaseURL
+	if a.Options.Replay != "" && baseURL == "" && a.Options.Host == "" {
+		baseURL = replayBaseURL
+	}
+	c := yxc.New(yxc.Config{
+		Host:      a.Options.Host,
+		BaseURL:   baseURL,
+		APIPrefix: a.Options.APIPrefix,
+		Timeout:   a.Options.Timeout,
+		Retries:   a.Options.Retries,
+		Retry:     a.Options.Retry,
+		Throttle:  a.Options.Throttle,
+		Auth:      a.Options.Auth,
+		Headers:   a.Options.Headers,
+		Proxy:     a.Options.Proxy,
+	})
+	if a.Options.Record != "" || a.Options.Replay != "" {
+		// OpenSession has already reported any error.
+		c.HTTPClient, _ = sessionClient(a.Options)
+	}
+	if a.Options.Replay != "" {
+		c.Config.Throttle = yxc.Throttle{}
+	}
+	if a.Options.DryRun != "" {
+		c.DryRun = a.printRequest
+	}
+	if !a.Options.NoValidate {
+		c.Validate = a.validateRequest
+	}
+	if a.Options.Strict {
+		c.CheckResponse = a.validateResponse
+	}
+	if a.Options.Verbose > 0 && !a.Options.Quiet {
+		c.Trace = func(req *http.Request, status int) {
+			_, _ = fmt.Fprintf(os.Stderr, "%s %s -> %d\n", req.Method, req.URL.String(), status)
+		}
+		c.OnRetry = func(method, path string, attempt int, reason error, delay time.Duration) {
+			_, _ = fmt.Fprintf(os.Stderr, "%s %s: %v; retry %d in %s\n", method, path, reason, attempt, delay.Round(time.Millisecond))
+		}
+	}
+	return c
+}
+
+func (a *App) show(resp interface{ Raw() []byte }, err error) error {
+	if rerr := a.render(resp.Raw()); rerr != nil {
+		return rerr
+	}
+	return err
+}
+
+func (a *App) call(ctx context.Context, method, path string, q url.Values, body []byte, contentType string) error {
+	resp, err := a.client.Do(ctx, method, path, q, body, contentType)
+	if resp == nil {
+		return err
+	}
+	if rerr := a.render(resp.Body); rerr != nil {
+		return rerr
+	}
+	if cerr := yxc.Check(path, resp); cerr != nil {
+		return cerr
+	}
+	return err
+}
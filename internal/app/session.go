@@ -0,0 +1,80 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/amannm/yxc/pkg/yxc"
+)
+
+// replayBaseURL stands in for the device address when replaying a session
+// without --host or --base-url; the replayer matches on path and query.
+const replayBaseURL = "http://replay.invalid/YamahaExtendedControl"
+
+type session struct {
+	client *http.Client
+	err    error
+}
+
+var (
+	sessionMu sync.Mutex
+	sessions  = map[string]session{}
+)
+
+// OpenSession opens the --record or --replay file up front so a bad path or
+// a malformed session fails the command before any request is made.
+func OpenSession(opts Options) error {
+	if opts.Record == "" && opts.Replay == "" {
+		return nil
+	}
+	_, err := sessionClient(opts)
+	return err
+}
+
+// sessionClient returns the HTTP client for --record or --replay. It is
+// shared by every App in the process so fan-out writes one session file and
+// consumes one replay.
+func sessionClient(opts Options) (*http.Client, error) {
+	key := opts.Record + "\x00" + opts.Replay + "\x00" + opts.Proxy
+	sessionMu.Lock()
+	defer sessionMu.Unlock()
+	s, ok := sessions[key]
+	if !ok {
+		rt, err := sessionTransport(opts)
+		if err == nil {
+			s.client = &http.Client{Transport: rt}
+		}
+		s.err = err
+		sessions[key] = s
+	}
+	return s.client, s.err
+}
+
+func sessionTransport(opts Options) (http.RoundTripper, error) {
+	if opts.Record != "" && opts.Replay != "" {
+		return nil, fmt.Errorf("--record and --replay cannot be combined")
+	}
+	if opts.Replay != "" {
+		f, err := os.Open(opts.Replay)
+		if err != nil {
+			return nil, fmt.Errorf("replay: %w", err)
+		}
+		defer f.Close()
+		entries, err := yxc.ReadSession(f)
+		if err != nil {
+			return nil, fmt.Errorf("replay %s: %w", opts.Replay, err)
+		}
+		return yxc.NewReplayer(entries), nil
+	}
+	base, err := yxc.NewHTTPClient(opts.Proxy)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(opts.Record, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("record: %w", err)
+	}
+	return &yxc.Recorder{Next: base.Transport, W: f}, nil
+}
@@ -0,0 +1,181 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const mediaRendererST = "urn:schemas-upnp-org:device:MediaRenderer:1"
+
+var ssdpGroup = &net.UDPAddr{IP: net.IPv4(239, 255, 255, 250), Port: 1900}
+
+type upnpDescription struct {
+	Device struct {
+		FriendlyName string `xml:"friendlyName"`
+		Manufacturer string `xml:"manufacturer"`
+		ModelName    string `xml:"modelName"`
+		UDN          string `xml:"UDN"`
+	} `xml:"device"`
+	Yamaha *struct {
+		URLBase  string `xml:"X_URLBase"`
+		Services []struct {
+			SpecType   string `xml:"X_specType"`
+			ControlURL string `xml:"X_yxcControlURL"`
+		} `xml:"X_serviceList>X_service"`
+	} `xml:"urn:schemas-yamaha-com:device-1-0 X_device"`
+}
+
+func searchSSDP(ctx context.Context, timeout time.Duration) ([]discoveredDevice, error) {
+	search, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	locations, err := msearch(search, mediaRendererST)
+	if err != nil {
+		return nil, err
+	}
+	results := []discoveredDevice{}
+	for _, loc := range locations {
+		dev, err := describeSSDP(ctx, loc, 2*time.Second)
+		if err == nil {
+			results = append(results, dev)
+		}
+	}
+	return results, nil
+}
+
+func msearch(ctx context.Context, st string) ([]string, error) {
+	mx := 2
+	if deadline, ok := ctx.Deadline(); ok {
+		if secs := int(time.Until(deadline) / time.Second); secs >= 1 && secs < mx {
+			mx = secs
+		}
+	}
+	req := "M-SEARCH * HTTP/1.1\r\n" +
+		"HOST: " + ssdpGroup.String() + "\r\n" +
+		"MAN: \"ssdp:discover\"\r\n" +
+		"MX: " + strconv.Itoa(mx) + "\r\n" +
+		"ST: " + st + "\r\n\r\n"
+	conns, err := multicastConns()
+	if err != nil {
+		return nil, err
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	seen := map[string]struct{}{}
+	locations := []string{}
+	sent := 0
+	for _, conn := range conns {
+		if _, err := conn.WriteToUDP([]byte(req), ssdpGroup); err != nil {
+			_ = conn.Close()
+			continue
+		}
+		sent++
+		wg.Add(1)
+		go func(conn *net.UDPConn) {
+			defer wg.Done()
+			defer conn.Close()
+			readPackets(ctx, conn, func(pkt []byte, _ *net.UDPAddr) {
+				loc, ok := ssdpLocation(pkt)
+				if !ok {
+					return
+				}
+				mu.Lock()
+				if _, ok := seen[loc]; !ok {
+					seen[loc] = struct{}{}
+					locations = append(locations, loc)
+				}
+				mu.Unlock()
+			})
+		}(conn)
+	}
+	if sent == 0 {
+		return nil, errors.New("ssdp: no interface accepted the search")
+	}
+	wg.Wait()
+	return locations, nil
+}
+
+// ssdpLocation returns the description URL an M-SEARCH response points to.
+func ssdpLocation(pkt []byte) (string, bool) {
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(pkt)), nil)
+	if err != nil {
+		return "", false
+	}
+	_ = resp.Body.Close()
+	loc := strings.TrimSpace(resp.Header.Get("Location"))
+	if resp.StatusCode != http.StatusOK || loc == "" {
+		return "", false
+	}
+	return loc, true
+}
+
+func describeSSDP(ctx context.Context, location string, timeout time.Duration) (discoveredDevice, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return discoveredDevice{}, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return discoveredDevice{}, err
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	_ = resp.Body.Close()
+	if err != nil {
+		return discoveredDevice{}, err
+	}
+	var desc upnpDescription
+	if err := xml.Unmarshal(body, &desc); err != nil {
+		return discoveredDevice{}, err
+	}
+	if desc.Device.Manufacturer != "Yamaha Corporation" || desc.Yamaha == nil {
+		return discoveredDevice{}, errors.New("ssdp: not a MusicCast device")
+	}
+	control := ""
+	for _, svc := range desc.Yamaha.Services {
+		if svc.ControlURL != "" {
+			control = svc.ControlURL
+			break
+		}
+	}
+	if control == "" {
+		return discoveredDevice{}, errors.New("ssdp: no extended control url")
+	}
+	base := desc.Yamaha.URLBase
+	if base == "" {
+		base = location
+	}
+	root, err := url.Parse(base)
+	if err != nil {
+		return discoveredDevice{}, err
+	}
+	host := root.Hostname()
+	port := 80
+	if p, err := strconv.Atoi(root.Port()); err == nil {
+		port = p
+	}
+	addrs := []string{}
+	if ip := net.ParseIP(host); ip != nil {
+		addrs = append(addrs, ip.String())
+	}
+	return discoveredDevice{
+		Name:      desc.Device.FriendlyName,
+		Type:      mediaRendererST,
+		Host:      host,
+		Port:      port,
+		Addresses: addrs,
+		BaseURL:   deviceBaseURL(host, port),
+		Source:    "ssdp",
+	}, nil
+}
@@ -0,0 +1,77 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSSDPLocation(t *testing.T) {
+	for _, tc := range []struct{ pkt, want string }{
+		{
+			pkt: "HTTP/1.1 200 OK\r\nCACHE-CONTROL: max-age=1800\r\n" +
+				"LOCATION: http://192.168.1.50:49154/MediaRenderer/desc.xml\r\n" +
+				"ST: urn:schemas-upnp-org:device:MediaRenderer:1\r\n" +
+				"USN: uuid:9ab0c000::urn:schemas-upnp-org:device:MediaRenderer:1\r\n\r\n",
+			want: "http://192.168.1.50:49154/MediaRenderer/desc.xml",
+		},
+		{pkt: "HTTP/1.1 200 OK\r\nST: upnp:rootdevice\r\n\r\n"},
+		{pkt: "HTTP/1.1 404 Not Found\r\nLOCATION: http://192.168.1.9/desc.xml\r\n\r\n"},
+		{pkt: "NOTIFY * HTTP/1.1\r\nHOST: 239.255.255.250:1900\r\n\r\n"},
+		{pkt: "garbage"},
+	} {
+		got, ok := ssdpLocation([]byte(tc.pkt))
+		if got != tc.want || ok != (tc.want != "") {
+			t.Errorf("ssdpLocation(%.30q) = %q, %v; want %q", tc.pkt, got, ok, tc.want)
+		}
+	}
+}
+
+const yamahaDescription = `<?xml version="1.0"?>
+<root xmlns="urn:schemas-upnp-org:device-1-0" xmlns:yamaha="urn:schemas-yamaha-com:device-1-0">
+  <specVersion><major>1</major><minor>0</minor></specVersion>
+  <device>
+    <deviceType>urn:schemas-upnp-org:device:MediaRenderer:1</deviceType>
+    <friendlyName>Living Room</friendlyName>
+    <manufacturer>MANUFACTURER</manufacturer>
+    <modelName>RX-V6A</modelName>
+    <UDN>uuid:9ab0c000-f668-11de-9976-00a0de000000</UDN>
+  </device>
+  <yamaha:X_device>
+    <yamaha:X_URLBase>BASE</yamaha:X_URLBase>
+    <yamaha:X_serviceList>
+      <yamaha:X_service>
+        <yamaha:X_specType>urn:schemas-yamaha-com:service:X_YamahaExtendedControl:1</yamaha:X_specType>
+        <yamaha:X_yxcControlURL>/YamahaExtendedControl/v1/</yamaha:X_yxcControlURL>
+      </yamaha:X_service>
+    </yamaha:X_serviceList>
+  </yamaha:X_device>
+</root>`
+
+func TestDescribeSSDP(t *testing.T) {
+	manufacturer := "Yamaha Corporation"
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		desc := strings.NewReplacer("MANUFACTURER", manufacturer, "BASE", srv.URL+"/").Replace(yamahaDescription)
+		_, _ = w.Write([]byte(desc))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	d, err := describeSSDP(ctx, srv.URL+"/MediaRenderer/desc.xml", time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Name != "Living Room" || d.Host != "127.0.0.1" || d.Source != "ssdp" ||
+		d.BaseURL != srv.URL+"/YamahaExtendedControl" {
+		t.Errorf("device = %+v", d)
+	}
+
+	manufacturer = "Other Corporation"
+	if _, err := describeSSDP(ctx, srv.URL+"/MediaRenderer/desc.xml", time.Second); err == nil {
+		t.Error("a renderer from another manufacturer was accepted")
+	}
+}
@@ -0,0 +1,28 @@
+package app
+
+import "github.com/spf13/cobra"
+
+func (a *App) State(cmd *cobra.Command) error {
+	follow, err := cmd.Flags().GetBool("follow")
+	if err != nil {
+		return err
+	}
+	ctx := cmd.Context()
+	s := a.client.NewState()
+	if !follow {
+		if err := s.Load(ctx); err != nil {
+			return err
+		}
+		return a.renderValue(s.Snapshot())
+	}
+	l, appName, renew, err := a.listenEvents(cmd)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+	// Follow loads the state after subscribing, then calls back with the
+	// first snapshot.
+	return interrupted(ctx, s.Follow(ctx, l, appName, renew, func(changed []string) error {
+		return a.renderValue(s.Snapshot())
+	}))
+}
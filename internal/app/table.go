@@ -0,0 +1,351 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// ANSI colors for table cells. Every code is five bytes long so that colored
+// and plain cells carry the same invisible width and tabwriter, which counts
+// bytes, still lines columns up.
+const (
+	ansiPlain  = "\x1b[39m"
+	ansiBold   = "\x1b[01m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+	ansiReset  = "\x1b[0m"
+)
+
+// table is one aligned block: a key/value listing when header is nil, rows
+// under a header otherwise.
+type table struct {
+	title  string
+	header []string
+	rows   [][]cell
+}
+
+type cell struct {
+	key   string
+	value any
+}
+
+// renderTable prints v as aligned tables. Nested objects are flattened into
+// dotted keys; arrays of objects become their own tables after the one that
+// contains them, titled with their path. --columns selects and orders
+// columns (dotted names) and --sort-by orders rows, descending with a "-"
+// prefix.
+func (a *App) renderTable(v any) error {
+	tables := []*table{}
+	switch t := v.(type) {
+	case map[string]any:
+		kv := &table{}
+		tables = append(tables, kv)
+		tables = append(tables, flattenInto(kv, "", t)...)
+	case []any:
+		if objects, ok := objectList(t); ok {
+			tables = append(tables, rowTables("", objects)...)
+		} else {
+			kv := &table{}
+			for _, item := range t {
+				kv.rows = append(kv.rows, []cell{{value: item}})
+			}
+			tables = append(tables, kv)
+		}
+	default:
+		tables = append(tables, &table{rows: [][]cell{{{value: t}}}})
+	}
+	color := a.colorEnabled()
+	first := true
+	for _, t := range tables {
+		if !a.selectColumns(t) || len(t.rows) == 0 {
+			continue
+		}
+		a.sortRows(t)
+		if !first {
+			if _, err := fmt.Fprintln(a.out); err != nil {
+				return err
+			}
+		}
+		first = false
+		if err := writeTable(a.out, t, color); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flattenInto adds the scalar fields of m to kv under dotted keys and returns
+// the tables for arrays of objects found along the way.
+func flattenInto(kv *table, prefix string, m map[string]any) []*table {
+	var sub []*table
+	for _, k := range sortedKeys(m) {
+		key := prefix + k
+		switch t := m[k].(type) {
+		case map[string]any:
+			sub = append(sub, flattenInto(kv, key+".", t)...)
+		case []any:
+			if objects, ok := objectList(t); ok {
+				sub = append(sub, rowTables(key, objects)...)
+				continue
+			}
+			kv.rows = append(kv.rows, []cell{{value: key}, {key: key, value: t}})
+		default:
+			kv.rows = append(kv.rows, []cell{{value: key}, {key: key, value: t}})
+		}
+	}
+	return sub
+}
+
+// rowTables lays out a list of objects as one row per object. Nested arrays
+// of objects inside the rows become tables of their own, with the row index
+// in their title.
+func rowTables(title string, objects []map[string]any) []*table {
+	t := &table{title: title}
+	flat := make([]map[string]any, len(objects))
+	seen := map[string]bool{}
+	var sub []*table
+	for i, obj := range objects {
+		flat[i] = map[string]any{}
+		flattenRow(flat[i], "", obj, func(key string, list []map[string]any) {
+			sub = append(sub, rowTables(fmt.Sprintf("%s[%d].%s", title, i, key), list)...)
+		})
+		for k := range flat[i] {
+			if !seen[k] {
+				seen[k] = true
+				t.header = append(t.header, k)
+			}
+		}
+	}
+	sort.Strings(t.header)
+	for _, row := range flat {
+		cells := make([]cell, len(t.header))
+		for j, k := range t.header {
+			cells[j] = cell{key: k, value: row[k]}
+		}
+		t.rows = append(t.rows, cells)
+	}
+	return append([]*table{t}, sub...)
+}
+
+func flattenRow(out map[string]any, prefix string, m map[string]any, nested func(string, []map[string]any)) {
+	for k, v := range m {
+		key := prefix + k
+		switch t := v.(type) {
+		case map[string]any:
+			flattenRow(out, key+".", t, nested)
+		case []any:
+			if objects, ok := objectList(t); ok {
+				nested(key, objects)
+				continue
+			}
+			out[key] = t
+		default:
+			out[key] = t
+		}
+	}
+}
+
+func objectList(items []any) ([]map[string]any, bool) {
+	if len(items) == 0 {
+		return nil, false
+	}
+	out := make([]map[string]any, len(items))
+	for i, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		out[i] = m
+	}
+	return out, true
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// selectColumns applies --columns to t and reports whether anything is left
+// to print. Tables without any of the requested columns are dropped.
+func (a *App) selectColumns(t *table) bool {
+	if len(a.Options.Columns) == 0 {
+		return true
+	}
+	if t.header == nil {
+		keep := [][]cell{}
+		for _, col := range a.Options.Columns {
+			for _, row := range t.rows {
+				if len(row) == 2 && row[1].key == col {
+					keep = append(keep, row)
+				}
+			}
+		}
+		t.rows = keep
+		return len(keep) > 0
+	}
+	idx := []int{}
+	for _, col := range a.Options.Columns {
+		for j, h := range t.header {
+			if h == col {
+				idx = append(idx, j)
+			}
+		}
+	}
+	if len(idx) == 0 {
+		return false
+	}
+	header := make([]string, len(idx))
+	for i, j := range idx {
+		header[i] = t.header[j]
+	}
+	for r, row := range t.rows {
+		cells := make([]cell, len(idx))
+		for i, j := range idx {
+			cells[i] = row[j]
+		}
+		t.rows[r] = cells
+	}
+	t.header = header
+	return true
+}
+
+func (a *App) sortRows(t *table) {
+	col, desc := strings.CutPrefix(strings.TrimSpace(a.Options.SortBy), "-")
+	if col == "" || t.header == nil {
+		return
+	}
+	j := -1
+	for i, h := range t.header {
+		if h == col {
+			j = i
+		}
+	}
+	if j < 0 {
+		return
+	}
+	sort.SliceStable(t.rows, func(x, y int) bool {
+		l, r := t.rows[x][j].value, t.rows[y][j].value
+		if desc {
+			l, r = r, l
+		}
+		return lessValue(l, r)
+	})
+}
+
+// lessValue orders numbers numerically and everything else by its text.
+// Missing values sort last.
+func lessValue(l, r any) bool {
+	if l == nil || r == nil {
+		return l != nil
+	}
+	lf, lok := l.(float64)
+	rf, rok := r.(float64)
+	if lok && rok {
+		return lf < rf
+	}
+	return cellText(l) < cellText(r)
+}
+
+func writeTable(out io.Writer, t *table, color bool) error {
+	if t.title != "" {
+		if _, err := fmt.Fprintln(out, t.title+":"); err != nil {
+			return err
+		}
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	if t.header != nil {
+		cols := make([]string, len(t.header))
+		for i, h := range t.header {
+			cols[i] = paint(h, ansiBold, color)
+		}
+		if _, err := fmt.Fprintln(w, strings.Join(cols, "\t")); err != nil {
+			return err
+		}
+	}
+	for _, row := range t.rows {
+		cols := make([]string, len(row))
+		for i, c := range row {
+			cols[i] = paint(cellText(c.value), cellColor(c), color)
+		}
+		if _, err := fmt.Fprintln(w, strings.Join(cols, "\t")); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// cellText is valueString with lists of scalars joined for readability.
+func cellText(v any) string {
+	items, ok := v.([]any)
+	if !ok {
+		return valueString(v)
+	}
+	if _, nested := objectList(items); nested {
+		return valueString(v)
+	}
+	parts := make([]string, len(items))
+	for i, item := range items {
+		parts[i] = cellText(item)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// cellColor highlights booleans and power states.
+func cellColor(c cell) string {
+	switch t := c.value.(type) {
+	case bool:
+		if t {
+			return ansiGreen
+		}
+		return ansiRed
+	case string:
+		if c.key != "power" && !strings.HasSuffix(c.key, ".power") {
+			return ansiPlain
+		}
+		switch t {
+		case "on":
+			return ansiGreen
+		case "standby":
+			return ansiYellow
+		case "off":
+			return ansiRed
+		}
+	}
+	return ansiPlain
+}
+
+func paint(s, code string, color bool) string {
+	if !color {
+		return s
+	}
+	return code + s + ansiReset
+}
+
+// colorEnabled reports whether output may use ANSI colors: only on a
+// terminal, and never with --no-color or a non-empty NO_COLOR.
+func (a *App) colorEnabled() bool {
+	if a.Options.NoColor || os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	return isTerminal(a.out)
+}
+
+// isTerminal reports whether w is a character device. Tests replace it to
+// stand in for a terminal.
+var isTerminal = func(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,153 @@
+package app
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestRenderTable(t *testing.T) {
+	status := `{"power":"on","volume":40,"mute":false,"tone_control":{"mode":"manual","bass":-2,"treble":1},"input_list":["hdmi1","tuner"]}`
+	list := `{"menu_name":"Net Radio","index":0,"list_info":[` +
+		`{"text":"Jazz","attribute":2,"thumbnail":{"url":"a.jpg"}},` +
+		`{"text":"Blues","attribute":125829120,"tracks":[{"num":1},{"num":2}]}]}`
+	for _, tc := range []struct {
+		name string
+		opts Options
+		in   string
+		want string
+	}{
+		{
+			name: "nested object",
+			in:   status,
+			want: "input_list           hdmi1, tuner\n" +
+				"mute                 false\n" +
+				"power                on\n" +
+				"tone_control.bass    -2\n" +
+				"tone_control.mode    manual\n" +
+				"tone_control.treble  1\n" +
+				"volume               40\n",
+		},
+		{
+			name: "arrays of objects as sub-tables",
+			in:   list,
+			want: "index      0\n" +
+				"menu_name  Net Radio\n" +
+				"\n" +
+				"list_info:\n" +
+				"attribute  text   thumbnail.url\n" +
+				"2          Jazz   a.jpg\n" +
+				"125829120  Blues  \n" +
+				"\n" +
+				"list_info[1].tracks:\n" +
+				"num\n" +
+				"1\n" +
+				"2\n",
+		},
+		{
+			name: "top-level list",
+			in:   `[{"id":"main","power":"standby"},{"id":"zone2","power":"off","mute":true}]`,
+			want: "id     mute  power\n" +
+				"main         standby\n" +
+				"zone2  true  off\n",
+		},
+		{
+			name: "scalars",
+			in:   `[1,"a",null]`,
+			want: "1\na\n\n",
+		},
+		{
+			name: "columns select and order keys",
+			opts: Options{Columns: []string{"volume", "tone_control.bass", "power"}},
+			in:   status,
+			want: "volume             40\n" +
+				"tone_control.bass  -2\n" +
+				"power              on\n",
+		},
+		{
+			name: "columns drop tables without them",
+			opts: Options{Columns: []string{"text", "attribute"}},
+			in:   list,
+			want: "list_info:\n" +
+				"text   attribute\n" +
+				"Jazz   2\n" +
+				"Blues  125829120\n",
+		},
+		{
+			name: "columns matching nothing",
+			opts: Options{Columns: []string{"nope"}},
+			in:   status,
+			want: "",
+		},
+		{
+			name: "sort descending",
+			opts: Options{SortBy: "-attribute"},
+			in:   list,
+			want: "index      0\n" +
+				"menu_name  Net Radio\n" +
+				"\n" +
+				"list_info:\n" +
+				"attribute  text   thumbnail.url\n" +
+				"125829120  Blues  \n" +
+				"2          Jazz   a.jpg\n" +
+				"\n" +
+				"list_info[1].tracks:\n" +
+				"num\n" +
+				"1\n" +
+				"2\n",
+		},
+		{
+			name: "sort by text, missing values last",
+			opts: Options{SortBy: "mute"},
+			in:   `[{"id":"a","mute":true},{"id":"b"},{"id":"c","mute":false}]`,
+			want: "id  mute\n" +
+				"c   false\n" +
+				"a   true\n" +
+				"b   \n",
+		},
+	} {
+		tc.opts.Format = "table"
+		if got := renderString(t, tc.opts, tc.in); got != tc.want {
+			t.Errorf("%s:\n%s\nwant:\n%s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestRenderTableColor(t *testing.T) {
+	defer func(f func(io.Writer) bool) { isTerminal = f }(isTerminal)
+	isTerminal = func(io.Writer) bool { return true }
+	in := `[{"id":"main","power":"on","mute":false},{"id":"zone2","power":"standby","mute":true}]`
+	colored := "\x1b[01mid\x1b[0m     \x1b[01mmute\x1b[0m   \x1b[01mpower\x1b[0m\n" +
+		"\x1b[39mmain\x1b[0m   \x1b[31mfalse\x1b[0m  \x1b[32mon\x1b[0m\n" +
+		"\x1b[39mzone2\x1b[0m  \x1b[32mtrue\x1b[0m   \x1b[33mstandby\x1b[0m\n"
+	plain := "id     mute   power\n" +
+		"main   false  on\n" +
+		"zone2  true   standby\n"
+	if got := renderString(t, Options{Format: "table"}, in); got != colored {
+		t.Errorf("terminal:\n%q\nwant:\n%q", got, colored)
+	}
+	if got := renderString(t, Options{Format: "table", NoColor: true}, in); got != plain {
+		t.Errorf("--no-color:\n%q\nwant:\n%q", got, plain)
+	}
+	t.Setenv("NO_COLOR", "1")
+	if got := renderString(t, Options{Format: "table"}, in); got != plain {
+		t.Errorf("NO_COLOR:\n%q\nwant:\n%q", got, plain)
+	}
+}
+
+func TestColorEnabledTerminal(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+	for _, out := range []io.Writer{w, &bytes.Buffer{}} {
+		a := New(Options{})
+		a.out = out
+		if a.colorEnabled() {
+			t.Errorf("color enabled for %T that is not a terminal", out)
+		}
+	}
+}
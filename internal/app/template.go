@@ -0,0 +1,165 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const templatePrefix = "template="
+
+// templateText returns the template of a --format template=... value.
+func templateText(format string) (string, bool) {
+	format = strings.TrimSpace(format)
+	if len(format) < len(templatePrefix) || !strings.EqualFold(format[:len(templatePrefix)], templatePrefix) {
+		return "", false
+	}
+	return format[len(templatePrefix):], true
+}
+
+// templateFuncs are the helpers available to --format template=...:
+//
+//	dB .actual_volume          "-40.5 dB" from an actual_volume object or a number
+//	dB .volume -80.5 0.5       converts a volume step to dB given the range minimum and step
+//	duration .play_time        seconds as m:ss or h:mm:ss
+//	join ", " .input_list      joins a list (either argument order)
+//	default "-" .artist        the value, or the fallback when it is missing or ""
+//	json .zone                 the value as compact JSON
+var templateFuncs = template.FuncMap{
+	"dB":       templateDB,
+	"duration": templateDuration,
+	"join":     templateJoin,
+	"default":  templateDefault,
+	"json":     templateJSON,
+}
+
+func parseTemplate(text string) (*template.Template, error) {
+	t, err := template.New("format").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("--format template: %w", err)
+	}
+	return t, nil
+}
+
+func (a *App) renderTemplate(text string, v any) error {
+	t, err := parseTemplate(text)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, v); err != nil {
+		return fmt.Errorf("--format template: %w", err)
+	}
+	out := b.String()
+	if !strings.HasSuffix(out, "\n") {
+		out += "\n"
+	}
+	_, err = a.out.Write([]byte(out))
+	return err
+}
+
+func toFloat(v any) (float64, bool) {
+	switch t := v.(type) {
+	case float64:
+		return t, true
+	case int:
+		return float64(t), true
+	case json.Number:
+		f, err := t.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(t), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func templateDB(v any, scale ...any) (string, error) {
+	if m, ok := v.(map[string]any); ok {
+		value, ok := toFloat(m["value"])
+		if !ok {
+			return "", fmt.Errorf("dB: no value in %s", valueString(v))
+		}
+		unit, _ := m["unit"].(string)
+		if unit == "" {
+			unit = "dB"
+		}
+		return strconv.FormatFloat(value, 'f', 1, 64) + " " + unit, nil
+	}
+	f, ok := toFloat(v)
+	if !ok {
+		return "", fmt.Errorf("dB: %s is not a number", valueString(v))
+	}
+	switch len(scale) {
+	case 0:
+	case 2:
+		lo, ok1 := toFloat(scale[0])
+		step, ok2 := toFloat(scale[1])
+		if !ok1 || !ok2 {
+			return "", fmt.Errorf("dB: range minimum and step must be numbers")
+		}
+		f = lo + f*step
+	default:
+		return "", fmt.Errorf("dB: want a value, or a volume with the range minimum and step")
+	}
+	return strconv.FormatFloat(f, 'f', 1, 64) + " dB", nil
+}
+
+func templateDuration(v any) (string, error) {
+	var d time.Duration
+	if s, ok := v.(string); ok {
+		if parsed, err := time.ParseDuration(s); err == nil {
+			d = parsed
+		}
+	}
+	if d == 0 {
+		f, ok := toFloat(v)
+		if !ok {
+			return "", fmt.Errorf("duration: %s is not a number of seconds", valueString(v))
+		}
+		d = time.Duration(f * float64(time.Second))
+	}
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	secs := int64(math.Round(d.Seconds()))
+	h, m, s := secs/3600, secs/60%60, secs%60
+	if h > 0 {
+		return fmt.Sprintf("%s%d:%02d:%02d", sign, h, m, s), nil
+	}
+	return fmt.Sprintf("%s%d:%02d", sign, m, s), nil
+}
+
+func templateJoin(a, b any) (string, error) {
+	sep, list := a, b
+	if _, ok := a.([]any); ok {
+		sep, list = b, a
+	}
+	s, ok := sep.(string)
+	items, isList := list.([]any)
+	if !ok || (!isList && list != nil) {
+		return "", fmt.Errorf("join: want a separator and a list")
+	}
+	parts := make([]string, len(items))
+	for i, item := range items {
+		parts[i] = valueString(item)
+	}
+	return strings.Join(parts, s), nil
+}
+
+func templateDefault(fallback, v any) any {
+	if v == nil || v == "" {
+		return fallback
+	}
+	return v
+}
+
+func templateJSON(v any) (string, error) {
+	out, err := json.Marshal(v)
+	return string(out), err
+}
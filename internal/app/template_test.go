@@ -0,0 +1,72 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	var status any
+	doc := `{"volume":60,"max_volume":161,"mute":false,"balance":0,"artist":"","album":"Kind of Blue",` +
+		`"actual_volume":{"mode":"db","value":-50.5,"unit":"dB"},"play_time":3725,"total_time":"90s",` +
+		`"input_list":["tuner","net_radio","bluetooth"],"empty_list":[]}`
+	if err := json.Unmarshal([]byte(doc), &status); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name, text, want string
+	}{
+		{"dB object", `{{dB .actual_volume}}`, "-50.5 dB"},
+		{"dB number", `{{dB -40}}`, "-40.0 dB"},
+		{"dB volume step", `{{dB .volume -80.5 0.5}}`, "-50.5 dB"},
+		{"dB string", `{{dB "-20.25"}}`, "-20.2 dB"},
+		{"duration seconds", `{{duration .play_time}}`, "1:02:05"},
+		{"duration minutes", `{{duration 75}}`, "1:15"},
+		{"duration negative", `{{duration -5}}`, "-0:05"},
+		{"duration string", `{{duration .total_time}}`, "1:30"},
+		{"duration zero", `{{duration 0}}`, "0:00"},
+		{"join separator first", `{{join ", " .input_list}}`, "tuner, net_radio, bluetooth"},
+		{"join list first", `{{join .input_list "|"}}`, "tuner|net_radio|bluetooth"},
+		{"join empty", `[{{join "," .empty_list}}]`, "[]"},
+		{"join missing", `[{{join "," .nope}}]`, "[]"},
+		{"default present", `{{default "-" .album}}`, "Kind of Blue"},
+		{"default empty string", `{{default "-" .artist}}`, "-"},
+		{"default missing key", `{{default "-" .nope}}`, "-"},
+		{"default zero number", `{{default "-" .balance}}`, "0"},
+		{"default false", `{{default "-" .mute}}`, "false"},
+		{"json", `{{json .actual_volume}}`, `{"mode":"db","unit":"dB","value":-50.5}`},
+		{"combined", `{{.volume}}/{{.max_volume}} {{dB .actual_volume}}`, "60/161 -50.5 dB"},
+	} {
+		var out bytes.Buffer
+		a := New(Options{})
+		a.out = &out
+		if err := a.renderTemplate(tc.text, status); err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if got := strings.TrimSuffix(out.String(), "\n"); got != tc.want {
+			t.Errorf("%s: %s = %q, want %q", tc.name, tc.text, got, tc.want)
+		}
+	}
+
+	for _, tc := range []struct {
+		name, text, want string
+	}{
+		{"dB not a number", `{{dB .input_list}}`, "dB: "},
+		{"dB object without value", `{{dB .}}`, "dB: no value"},
+		{"dB one scale argument", `{{dB .volume -80.5}}`, "dB: want a value"},
+		{"dB bad scale", `{{dB .volume "low" 0.5}}`, "range minimum and step must be numbers"},
+		{"duration not a number", `{{duration .album}}`, "duration: "},
+		{"join without a list", `{{join "," .album}}`, "join: want a separator and a list"},
+		{"parse error", `{{.volume`, "--format template: "},
+	} {
+		a := New(Options{})
+		a.out = &bytes.Buffer{}
+		err := a.renderTemplate(tc.text, status)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: got %v, want an error containing %q", tc.name, err, tc.want)
+		}
+	}
+}
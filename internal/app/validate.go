@@ -0,0 +1,49 @@
+package app
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/amannm/yxc/internal/spec"
+	"github.com/amannm/yxc/pkg/yxc"
+)
+
+// specOperation finds the operation in the bundled spec for a request, along
+// with the request path relative to the API prefix. Endpoints the spec does
+// not describe are not checked.
+func (a *App) specOperation(req *http.Request) (*spec.Operation, string, error) {
+	s, err := spec.Load()
+	if err != nil {
+		return nil, "", err
+	}
+	prefix := a.client.API("")
+	i := strings.LastIndex(req.URL.Path, prefix)
+	if i < 0 {
+		return nil, "", nil
+	}
+	path := req.URL.Path[i+len(prefix):]
+	op, ok := s.Match(req.Method, path)
+	if !ok {
+		return nil, "", nil
+	}
+	return op, path, nil
+}
+
+func (a *App) validateRequest(req *http.Request, body []byte) error {
+	op, path, err := a.specOperation(req)
+	if op == nil {
+		return err
+	}
+	if err := op.ValidateRequest(path, req.URL.Query(), body); err != nil {
+		return err
+	}
+	return a.checkCapabilities(req, op, path)
+}
+
+func (a *App) validateResponse(resp *yxc.RawResponse) error {
+	op, _, err := a.specOperation(resp.Request)
+	if op == nil {
+		return err
+	}
+	return op.ValidateResponse(resp.Body)
+}
@@ -0,0 +1,187 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/amannm/yxc/pkg/yxc"
+)
+
+// volumeLevel is a zone volume written in decibels or as a percentage of the
+// zone's volume range: -25.5dB, 40%, or relative to the current volume with
+// a leading + (+3dB, +-3dB, +5%).
+type volumeLevel struct {
+	value    float64
+	unit     string
+	relative bool
+}
+
+// parseVolumeLevel reports whether s is a volume in dB or percent.
+func parseVolumeLevel(s string) (volumeLevel, bool) {
+	var l volumeLevel
+	switch {
+	case len(s) > 2 && strings.EqualFold(s[len(s)-2:], "db"):
+		l.unit, s = "dB", s[:len(s)-2]
+	case strings.HasSuffix(s, "%"):
+		l.unit, s = "%", strings.TrimSuffix(s, "%")
+	default:
+		return l, false
+	}
+	if strings.HasPrefix(s, "+") {
+		l.relative, s = true, s[1:]
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return l, false
+	}
+	l.value = v
+	return l, true
+}
+
+// setVolumeLevel sets the zone volume to l. Decibels are snapped to the
+// zone's actual_volume_db range_step and sent with setActualVolume, or
+// converted to a raw step for zones whose getFeatures lack actual_volume
+// or cannot be had; percentages go through setVolume using the zone's
+// volume range_step. Relative levels start from the zone's getStatus.
+func (a *App) setVolumeLevel(ctx context.Context, z *yxc.ZoneService, arg string, l volumeLevel) error {
+	zf := a.zoneFeature(ctx)
+	r, haveRange := volumeRange(zf, nil)
+	dr, haveDB := dbRange(zf)
+	actual := zf != nil && slices.Contains(zf.FuncList, "actual_volume")
+	if l.unit == "dB" && !actual && !haveDB {
+		return fmt.Errorf("zone volume: %s: the zone's volume range in dB is unknown", arg)
+	}
+	var status *yxc.ZoneStatus
+	if l.relative || (l.unit == "%" && !haveRange) || (!actual && !haveRange) {
+		if a.Options.DryRun != "" {
+			return fmt.Errorf("zone volume: %s needs the current volume, which --dry-run does not fetch", arg)
+		}
+		s, err := z.GetStatus(ctx)
+		if err != nil {
+			return err
+		}
+		status = s
+	}
+	if !haveRange {
+		r, haveRange = volumeRange(zf, status)
+	}
+	if l.unit == "dB" {
+		target := l.value
+		if l.relative {
+			db, ok := volumeDB(status)
+			if !ok && haveDB && haveRange {
+				db, ok = dr.Min+(float64(status.Volume)-r.Min)/(r.Max-r.Min)*(dr.Max-dr.Min), true
+			}
+			if !ok {
+				return fmt.Errorf("zone volume: %s: the zone does not report its volume in dB", arg)
+			}
+			target += db
+		}
+		if haveDB {
+			target = snapToRange(target, dr)
+		}
+		if actual {
+			return a.show(z.SetActualVolume(ctx, yxc.ActualVolumeRequest{Mode: "db", Value: yxc.Float(target)}))
+		}
+		if !haveRange {
+			return fmt.Errorf("zone volume: %s: the zone's volume range is unknown", arg)
+		}
+		raw := r.Min + (target-dr.Min)/(dr.Max-dr.Min)*(r.Max-r.Min)
+		return a.show(z.SetVolume(ctx, int(snapToRange(raw, r))))
+	}
+	if !haveRange {
+		return fmt.Errorf("zone volume: %s: the zone's volume range is unknown", arg)
+	}
+	var raw float64
+	if l.relative {
+		raw = float64(status.Volume) + l.value/100*(r.Max-r.Min)
+	} else {
+		if l.value < 0 || l.value > 100 {
+			return fmt.Errorf("zone volume: %s is outside 0..100%%", arg)
+		}
+		raw = r.Min + l.value/100*(r.Max-r.Min)
+	}
+	return a.show(z.SetVolume(ctx, int(snapToRange(raw, r))))
+}
+
+// showZoneStatus prints getStatus with the volume also given as a percentage
+// of the zone's volume range and, when the zone reports it, in decibels.
+func (a *App) showZoneStatus(ctx context.Context, z *yxc.ZoneService) error {
+	status, err := z.GetStatus(ctx)
+	if err != nil || len(status.Raw()) == 0 {
+		return a.show(status, err)
+	}
+	var v map[string]any
+	if json.Unmarshal(status.Raw(), &v) != nil {
+		return a.show(status, err)
+	}
+	if r, ok := volumeRange(a.zoneFeature(ctx), status); ok {
+		v["volume_percent"] = volumePercent(status.Volume, r)
+	}
+	if db, ok := volumeDB(status); ok {
+		v["volume_db"] = db
+	}
+	return a.renderValue(v)
+}
+
+// zoneFeature returns the getFeatures entry of the --zone zone, or nil when
+// the device's features cannot be had.
+func (a *App) zoneFeature(ctx context.Context) *yxc.ZoneFeature {
+	_, f := a.currentDevice(ctx)
+	if f == nil {
+		return nil
+	}
+	zf, _ := f.features.ZoneFeature(zoneOrDefault(a.Options.Zone))
+	return zf
+}
+
+// volumeRange is the zone's volume range_step, or failing that 0 up to the
+// max_volume in its status.
+func volumeRange(zf *yxc.ZoneFeature, status *yxc.ZoneStatus) (yxc.RangeStep, bool) {
+	if zf != nil {
+		if r, ok := zf.Range("volume"); ok && r.Max > r.Min {
+			return r, true
+		}
+	}
+	if status != nil && status.MaxVolume > 0 {
+		return yxc.RangeStep{ID: "volume", Max: float64(status.MaxVolume), Step: 1}, true
+	}
+	return yxc.RangeStep{}, false
+}
+
+// dbRange is the zone's actual_volume_db range_step: the volume range in
+// decibels.
+func dbRange(zf *yxc.ZoneFeature) (yxc.RangeStep, bool) {
+	if zf != nil {
+		if r, ok := zf.Range("actual_volume_db"); ok && r.Max > r.Min {
+			return r, true
+		}
+	}
+	return yxc.RangeStep{}, false
+}
+
+func volumeDB(status *yxc.ZoneStatus) (float64, bool) {
+	if status == nil || status.ActualVolume == nil || !strings.EqualFold(status.ActualVolume.Unit, "dB") {
+		return 0, false
+	}
+	return status.ActualVolume.Value, true
+}
+
+// volumePercent gives a raw volume as a percentage of r, to one decimal.
+func volumePercent(volume int, r yxc.RangeStep) float64 {
+	return math.Round((float64(volume)-r.Min)/(r.Max-r.Min)*1000) / 10
+}
+
+// snapToRange clamps v to r and rounds it to r's nearest step.
+func snapToRange(v float64, r yxc.RangeStep) float64 {
+	v = math.Max(r.Min, math.Min(r.Max, v))
+	if r.Step > 0 {
+		v = r.Min + math.Round((v-r.Min)/r.Step)*r.Step
+	}
+	return math.Min(r.Max, v)
+}
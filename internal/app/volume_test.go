@@ -0,0 +1,119 @@
+package app
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/amannm/yxc/pkg/yxc"
+	"github.com/amannm/yxc/pkg/yxc/mock"
+	"github.com/spf13/cobra"
+)
+
+func TestParseVolumeLevel(t *testing.T) {
+	for in, want := range map[string]volumeLevel{
+		"-25.5dB": {value: -25.5, unit: "dB"},
+		"-10DB":   {value: -10, unit: "dB"},
+		"+3dB":    {value: 3, unit: "dB", relative: true},
+		"+-3dB":   {value: -3, unit: "dB", relative: true},
+		"40%":     {value: 40, unit: "%"},
+		"+5%":     {value: 5, unit: "%", relative: true},
+	} {
+		if got, ok := parseVolumeLevel(in); !ok || got != want {
+			t.Errorf("parseVolumeLevel(%q) = %+v, %v; want %+v", in, got, ok, want)
+		}
+	}
+	for _, in := range []string{"40", "up", "dB", "%", "xdB", "NaN%", "loud"} {
+		if _, ok := parseVolumeLevel(in); ok {
+			t.Errorf("parseVolumeLevel(%q) accepted", in)
+		}
+	}
+}
+
+func TestVolumeConversion(t *testing.T) {
+	volume := yxc.RangeStep{Min: 0, Max: 161, Step: 1}
+	if got := snapToRange(0.4*161, volume); got != 64 {
+		t.Errorf("40%% = %v, want 64", got)
+	}
+	if got := volumePercent(64, volume); got != 39.8 {
+		t.Errorf("volumePercent(64) = %v, want 39.8", got)
+	}
+	db := yxc.RangeStep{Min: -80.5, Max: 16.5, Step: 0.5}
+	for in, want := range map[float64]float64{-25.3: -25.5, 20: 16.5, -90: -80.5} {
+		if got := snapToRange(in, db); got != want {
+			t.Errorf("snapToRange(%v) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+// TestZoneVolumeLevel sets volumes in dB and percent on the mock device,
+// including a zone whose getFeatures lack actual_volume, and checks the raw
+// volume each one ends up at.
+func TestZoneVolumeLevel(t *testing.T) {
+	d := mock.New()
+	defer d.Close()
+	srv := httptest.NewServer(d)
+	defer srv.Close()
+	ctx := context.Background()
+	a := New(Options{BaseURL: srv.URL + "/YamahaExtendedControl", Format: "json", Timeout: 2 * time.Second})
+	a.out = io.Discard
+	cmd := &cobra.Command{}
+	cmd.Flags().Int("step", 1, "")
+	cmd.SetContext(ctx)
+
+	set := func(zone, level string, want int) {
+		t.Helper()
+		a.Options.Zone = zone
+		if err := a.Zone(cmd, []string{"volume", level}); err != nil {
+			t.Fatalf("%s volume %s: %v", zone, level, err)
+		}
+		status, err := a.client.Zone(zone).GetStatus(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.Volume != want {
+			t.Errorf("%s volume %s = %d, want %d", zone, level, status.Volume, want)
+		}
+	}
+	set("main", "-25.5dB", 110)
+	set("main", "+3dB", 116)
+	set("main", "-25.3dB", 110)
+	set("main", "+-0.2dB", 110)
+	set("main", "40%", 64)
+	if n := d.Calls("main/setActualVolume"); n != 4 {
+		t.Errorf("main/setActualVolume called %d times, want 4", n)
+	}
+
+	_, f := a.currentDevice(ctx)
+	if f == nil {
+		t.Fatal("no features")
+	}
+	for i, z := range f.features.Zone {
+		if z.ID == "zone2" {
+			f.features.Zone[i].FuncList = slices.DeleteFunc(slices.Clone(z.FuncList), func(fn string) bool { return fn == "actual_volume" })
+		}
+	}
+	set("zone2", "-40dB", 81)
+	set("zone2", "-40.2dB", 81)
+	set("zone2", "+3dB", 87)
+	if n := d.Calls("zone2/setActualVolume"); n != 0 {
+		t.Errorf("zone2/setActualVolume called %d times, want 0", n)
+	}
+
+	// Without getFeatures, percentages still go through setVolume using the
+	// status's max_volume, and decibels are refused rather than sent with a
+	// setActualVolume the zone may not have.
+	d.Fail("system/getFeatures", 3)
+	a = New(Options{BaseURL: srv.URL + "/YamahaExtendedControl", Format: "json", Timeout: 2 * time.Second, Quiet: true})
+	a.out = io.Discard
+	set("main", "50%", 81)
+	if err := a.Zone(cmd, []string{"volume", "-30dB"}); err == nil {
+		t.Error("-30dB accepted without the zone's features")
+	}
+	if n := d.Calls("main/setActualVolume"); n != 4 {
+		t.Errorf("main/setActualVolume called %d times, want 4", n)
+	}
+}
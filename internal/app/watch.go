@@ -0,0 +1,170 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+type watchGetter func(a *App, ctx context.Context) ([]byte, error)
+
+var watchTargets = map[string]watchGetter{
+	"zone status": func(a *App, ctx context.Context) ([]byte, error) {
+		return rawBody(a.client.Zone(a.Options.Zone).GetStatus(ctx))
+	},
+	"tuner play-info": func(a *App, ctx context.Context) ([]byte, error) {
+		return rawBody(a.client.Tuner().GetPlayInfo(ctx))
+	},
+	"netusb play-info": func(a *App, ctx context.Context) ([]byte, error) {
+		return rawBody(a.client.NetUSB().GetPlayInfo(ctx))
+	},
+	"netusb preset-info": func(a *App, ctx context.Context) ([]byte, error) {
+		return rawBody(a.client.NetUSB().GetPresetInfo(ctx))
+	},
+	"netusb recent": func(a *App, ctx context.Context) ([]byte, error) {
+		return rawBody(a.client.NetUSB().GetRecentInfo(ctx))
+	},
+	"netusb settings": func(a *App, ctx context.Context) ([]byte, error) {
+		return rawBody(a.client.NetUSB().GetSettings(ctx))
+	},
+	"cd play-info": func(a *App, ctx context.Context) ([]byte, error) {
+		return rawBody(a.client.CD().GetPlayInfo(ctx))
+	},
+	"dist info": func(a *App, ctx context.Context) ([]byte, error) {
+		return rawBody(a.client.Dist().GetDistributionInfo(ctx))
+	},
+	"clock settings": func(a *App, ctx context.Context) ([]byte, error) {
+		return rawBody(a.client.Clock().GetSettings(ctx))
+	},
+	"system location": func(a *App, ctx context.Context) ([]byte, error) {
+		return rawBody(a.client.System().GetLocationInfo(ctx))
+	},
+}
+
+type diffRecord struct {
+	Time   string `json:"time"`
+	Target string `json:"target"`
+	Path   string `json:"path"`
+	Old    any    `json:"old"`
+	New    any    `json:"new"`
+}
+
+func WatchTargets() string {
+	names := make([]string, 0, len(watchTargets))
+	for name := range watchTargets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func rawBody[T interface{ Raw() []byte }](v T, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	return v.Raw(), nil
+}
+
+func (a *App) Watch(cmd *cobra.Command, args []string) error {
+	interval, err := cmd.Flags().GetDuration("interval")
+	if err != nil {
+		return err
+	}
+	if interval <= 0 {
+		return fmt.Errorf("watch: interval must be positive")
+	}
+	target := "zone status"
+	if len(args) > 0 {
+		target = strings.Join(args, " ")
+	}
+	get, ok := watchTargets[target]
+	if !ok {
+		return fmt.Errorf("watch: unknown target %s", target)
+	}
+	ctx := cmd.Context()
+	// prev is the baseline the next poll is diffed against; it is set by
+	// the first poll that succeeds, which may not be the first poll.
+	var prev any
+	baseline := false
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for first := true; ; first = false {
+		if !first {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+		body, err := get(a, ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			if !a.Options.Quiet {
+				_, _ = fmt.Fprintf(os.Stderr, "watch %s: %v\n", target, err)
+			}
+			continue
+		}
+		var cur any
+		if err := json.Unmarshal(body, &cur); err != nil {
+			return fmt.Errorf("watch %s: %w", target, err)
+		}
+		if !baseline {
+			prev, baseline = cur, true
+			continue
+		}
+		now := time.Now().Format(time.RFC3339Nano)
+		records := []diffRecord{}
+		diffValues("", prev, cur, func(path string, old, new any) {
+			records = append(records, diffRecord{Time: now, Target: target, Path: path, Old: old, New: new})
+		})
+		prev = cur
+		for _, r := range records {
+			out, err := json.Marshal(r)
+			if err != nil {
+				return err
+			}
+			if err := a.render(out); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func diffValues(path string, old, new any, emit func(path string, old, new any)) {
+	oldMap, oldOK := old.(map[string]any)
+	newMap, newOK := new.(map[string]any)
+	if !oldOK || !newOK {
+		if !reflect.DeepEqual(old, new) {
+			emit(path, old, new)
+		}
+		return
+	}
+	keys := map[string]struct{}{}
+	for k := range oldMap {
+		keys[k] = struct{}{}
+	}
+	for k := range newMap {
+		keys[k] = struct{}{}
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	for _, k := range sorted {
+		child := k
+		if path != "" {
+			child = path + "." + k
+		}
+		diffValues(child, oldMap[k], newMap[k], emit)
+	}
+}
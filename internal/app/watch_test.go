@@ -0,0 +1,55 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// TestWatchFailedFirstPoll checks that a failed first poll does not become
+// the baseline, which would report the whole document as changed.
+func TestWatchFailedFirstPoll(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	polls := []string{"", `{"a":1,"b":1}`, `{"a":2,"b":1}`}
+	watchTargets["test"] = func(a *App, ctx context.Context) ([]byte, error) {
+		if len(polls) == 0 {
+			cancel()
+			return nil, ctx.Err()
+		}
+		body := polls[0]
+		polls = polls[1:]
+		if body == "" {
+			return nil, errors.New("timeout")
+		}
+		return []byte(body), nil
+	}
+	defer delete(watchTargets, "test")
+
+	var out bytes.Buffer
+	a := New(Options{Quiet: true, Format: "json"})
+	a.out = &out
+	cmd := &cobra.Command{}
+	cmd.Flags().Duration("interval", time.Millisecond, "")
+	cmd.SetContext(ctx)
+	if err := a.Watch(cmd, []string{"test"}); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("got %d records, want 1:\n%s", len(lines), out.String())
+	}
+	var r diffRecord
+	if err := json.Unmarshal([]byte(lines[0]), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Path != "a" || r.Old != 1.0 || r.New != 2.0 {
+		t.Errorf("got %+v", r)
+	}
+}
@@ -0,0 +1,314 @@
+// Package spec loads the embedded OpenAPI description of the Extended
+// Control API and checks requests and responses against it.
+package spec
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/amannm/yxc/reference"
+	"gopkg.in/yaml.v3"
+)
+
+// Spec is the set of operations in the API description.
+type Spec struct {
+	Operations []*Operation
+	byID       map[string]*Operation
+	schemas    map[string]*Schema
+}
+
+// Operation is one method on one path. Path keeps the spec's form, e.g.
+// "/v1/{zone}/setVolume".
+type Operation struct {
+	ID          string
+	Method      string
+	Path        string
+	Tag         string
+	Summary     string
+	Description string
+	Params      []*Param
+	Body        *Schema
+	Response    *Schema
+	segments    []string
+}
+
+type Param struct {
+	Name        string  `yaml:"name"`
+	In          string  `yaml:"in"`
+	Required    bool    `yaml:"required"`
+	Description string  `yaml:"description"`
+	Schema      *Schema `yaml:"schema"`
+	Ref         string  `yaml:"$ref"`
+}
+
+// Schema is the subset of JSON Schema the API description uses. Name is set
+// for schemas defined under components.
+type Schema struct {
+	Name        string             `yaml:"-"`
+	Ref         string             `yaml:"$ref"`
+	Type        string             `yaml:"type"`
+	Enum        []any              `yaml:"enum"`
+	Properties  map[string]*Schema `yaml:"properties"`
+	Required    []string           `yaml:"required"`
+	Items       *Schema            `yaml:"items"`
+	OneOf       []*Schema          `yaml:"oneOf"`
+	Minimum     *float64           `yaml:"minimum"`
+	Maximum     *float64           `yaml:"maximum"`
+	MaxLength   *int               `yaml:"maxLength"`
+	Default     any                `yaml:"default"`
+	Description string             `yaml:"description"`
+}
+
+type document struct {
+	Paths      map[string]map[string]*rawOperation `yaml:"paths"`
+	Components struct {
+		Parameters map[string]*Param  `yaml:"parameters"`
+		Schemas    map[string]*Schema `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+type rawOperation struct {
+	OperationID string   `yaml:"operationId"`
+	Tags        []string `yaml:"tags"`
+	Summary     string   `yaml:"summary"`
+	Description string   `yaml:"description"`
+	Parameters  []*Param `yaml:"parameters"`
+	RequestBody *struct {
+		Content map[string]struct {
+			Schema *Schema `yaml:"schema"`
+		} `yaml:"content"`
+	} `yaml:"requestBody"`
+	Responses map[string]struct {
+		Content map[string]struct {
+			Schema *Schema `yaml:"schema"`
+		} `yaml:"content"`
+	} `yaml:"responses"`
+}
+
+var (
+	loadOnce sync.Once
+	loaded   *Spec
+	loadErr  error
+)
+
+// Load parses the embedded specification once.
+func Load() (*Spec, error) {
+	loadOnce.Do(func() {
+		loaded, loadErr = Parse(reference.OpenAPI)
+	})
+	return loaded, loadErr
+}
+
+// Parse reads an OpenAPI document, resolving local $refs.
+func Parse(data []byte) (*Spec, error) {
+	var doc document
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("spec: %w", err)
+	}
+	for name, c := range doc.Components.Schemas {
+		c.Name = name
+	}
+	r := resolver{schemas: doc.Components.Schemas, params: doc.Components.Parameters, done: map[*Schema]bool{}}
+	s := &Spec{byID: map[string]*Operation{}, schemas: map[string]*Schema{}}
+	for name, c := range doc.Components.Schemas {
+		c, err := r.schema(c)
+		if err != nil {
+			return nil, fmt.Errorf("spec: %s: %w", name, err)
+		}
+		s.schemas[name] = c
+	}
+	for path, methods := range doc.Paths {
+		for method, raw := range methods {
+			op := &Operation{
+				ID:          raw.OperationID,
+				Method:      strings.ToUpper(method),
+				Path:        path,
+				Summary:     raw.Summary,
+				Description: strings.TrimSpace(raw.Description),
+				segments:    strings.Split(strings.TrimPrefix(path, "/v1/"), "/"),
+			}
+			if len(raw.Tags) > 0 {
+				op.Tag = raw.Tags[0]
+			}
+			for _, p := range raw.Parameters {
+				p, err := r.param(p)
+				if err != nil {
+					return nil, fmt.Errorf("spec: %s: %w", op.ID, err)
+				}
+				op.Params = append(op.Params, p)
+			}
+			if raw.RequestBody != nil {
+				if c, ok := raw.RequestBody.Content["application/json"]; ok {
+					op.Body = c.Schema
+				}
+			}
+			if resp, ok := raw.Responses["200"]; ok {
+				if c, ok := resp.Content["application/json"]; ok {
+					op.Response = c.Schema
+				}
+			}
+			var err error
+			if op.Body, err = r.schema(op.Body); err != nil {
+				return nil, fmt.Errorf("spec: %s: %w", op.ID, err)
+			}
+			if op.Response, err = r.schema(op.Response); err != nil {
+				return nil, fmt.Errorf("spec: %s: %w", op.ID, err)
+			}
+			if op.ID == "" {
+				return nil, fmt.Errorf("spec: %s %s has no operationId", op.Method, path)
+			}
+			if _, dup := s.byID[op.ID]; dup {
+				return nil, fmt.Errorf("spec: duplicate operationId %s", op.ID)
+			}
+			s.byID[op.ID] = op
+			s.Operations = append(s.Operations, op)
+		}
+	}
+	sort.Slice(s.Operations, func(i, j int) bool { return s.Operations[i].ID < s.Operations[j].ID })
+	return s, nil
+}
+
+type resolver struct {
+	schemas map[string]*Schema
+	params  map[string]*Param
+	done    map[*Schema]bool
+}
+
+func (r *resolver) param(p *Param) (*Param, error) {
+	if p.Ref != "" {
+		name, ok := strings.CutPrefix(p.Ref, "#/components/parameters/")
+		target, found := r.params[name]
+		if !ok || !found {
+			return nil, fmt.Errorf("unresolved %s", p.Ref)
+		}
+		p = target
+	}
+	var err error
+	p.Schema, err = r.schema(p.Schema)
+	return p, err
+}
+
+// schema returns s with every $ref below it replaced by the component it
+// names.
+func (r *resolver) schema(s *Schema) (*Schema, error) {
+	if s == nil {
+		return nil, nil
+	}
+	if s.Ref != "" {
+		name, ok := strings.CutPrefix(s.Ref, "#/components/schemas/")
+		target, found := r.schemas[name]
+		if !ok || !found {
+			return nil, fmt.Errorf("unresolved %s", s.Ref)
+		}
+		s = target
+	}
+	if r.done[s] {
+		return s, nil
+	}
+	r.done[s] = true
+	var err error
+	for k, p := range s.Properties {
+		if s.Properties[k], err = r.schema(p); err != nil {
+			return nil, err
+		}
+	}
+	if s.Items, err = r.schema(s.Items); err != nil {
+		return nil, err
+	}
+	for i, alt := range s.OneOf {
+		if s.OneOf[i], err = r.schema(alt); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Operation returns the operation with the given operationId.
+func (s *Spec) Operation(id string) (*Operation, bool) {
+	op, ok := s.byID[id]
+	return op, ok
+}
+
+// Schema returns the component schema with the given name.
+func (s *Spec) Schema(name string) (*Schema, bool) {
+	c, ok := s.schemas[name]
+	return c, ok
+}
+
+// Find resolves a name given on the command line: an operationId in any
+// case, or the endpoint name from the path (e.g. "setVolume" for
+// setZoneVolume) when only one operation has it.
+func (s *Spec) Find(name string) (*Operation, error) {
+	if op, ok := s.byID[name]; ok {
+		return op, nil
+	}
+	var matches []*Operation
+	for _, op := range s.Operations {
+		if strings.EqualFold(op.ID, name) {
+			return op, nil
+		}
+		if strings.EqualFold(op.segments[len(op.segments)-1], name) {
+			matches = append(matches, op)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("unknown operation %s (see --list)", name)
+	case 1:
+		return matches[0], nil
+	}
+	ids := make([]string, len(matches))
+	for i, op := range matches {
+		ids[i] = op.ID
+	}
+	return nil, fmt.Errorf("%s is ambiguous: %s", name, strings.Join(ids, ", "))
+}
+
+// Match finds the operation for a request path relative to the API prefix,
+// e.g. "main/setVolume".
+func (s *Spec) Match(method, path string) (*Operation, bool) {
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	for _, op := range s.Operations {
+		if op.Method == method && op.matches(segs) {
+			return op, true
+		}
+	}
+	return nil, false
+}
+
+func (op *Operation) matches(segs []string) bool {
+	if len(segs) != len(op.segments) {
+		return false
+	}
+	for i, seg := range op.segments {
+		if !strings.HasPrefix(seg, "{") && seg != segs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// PathParams returns the values of the templated segments of path, keyed by
+// parameter name.
+func (op *Operation) PathParams(path string) map[string]string {
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	out := map[string]string{}
+	for i, seg := range op.segments {
+		if name, ok := strings.CutPrefix(seg, "{"); ok && i < len(segs) {
+			out[strings.TrimSuffix(name, "}")] = segs[i]
+		}
+	}
+	return out
+}
+
+// Param returns the parameter with the given name.
+func (op *Operation) Param(name string) (*Param, bool) {
+	for _, p := range op.Params {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,69 @@
+package spec
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	s, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	op, ok := s.Match("GET", "zone2/setVolume")
+	if !ok || op.ID != "setZoneVolume" {
+		t.Fatalf("Match zone2/setVolume = %v, %v", op, ok)
+	}
+	if got := op.PathParams("zone2/setVolume")["zone"]; got != "zone2" {
+		t.Errorf("zone = %q", got)
+	}
+	if _, ok := s.Match("GET", "main/noSuchThing"); ok {
+		t.Error("matched an unknown endpoint")
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	s, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	op, _ := s.Operation("setZonePower")
+	if err := op.ValidateRequest("main/setPower", url.Values{"power": {"on"}}, nil); err != nil {
+		t.Errorf("valid request rejected: %v", err)
+	}
+	err = op.ValidateRequest("main/setPower", url.Values{"power": {"off"}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "on, standby, toggle") {
+		t.Errorf("enum error = %v", err)
+	}
+	if err := op.ValidateRequest("main/setPower", nil, nil); err == nil {
+		t.Error("missing required parameter accepted")
+	}
+	vol, _ := s.Operation("setZoneVolume")
+	for v, ok := range map[string]bool{"40": true, "up": true, "loud": false} {
+		err := vol.ValidateRequest("main/setVolume", url.Values{"volume": {v}}, nil)
+		if (err == nil) != ok {
+			t.Errorf("volume=%s: %v", v, err)
+		}
+	}
+}
+
+func TestValidateResponse(t *testing.T) {
+	s, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	op, _ := s.Operation("getZoneStatus")
+	if err := op.ValidateResponse([]byte(`{"response_code":0,"power":"on","volume":40,"mute":false}`)); err != nil {
+		t.Errorf("valid response rejected: %v", err)
+	}
+	err = op.ValidateResponse([]byte(`{"response_code":0,"power":"sleeping","volume":"40","extra":1}`))
+	if err == nil {
+		t.Fatal("drift not reported")
+	}
+	for _, want := range []string{`power: "sleeping"`, `volume: expected integer`, `extra: not in the spec`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("%v: missing %q", err, want)
+		}
+	}
+}
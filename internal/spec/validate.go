@@ -0,0 +1,339 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Error lists where a request or response departs from the specification.
+type Error struct {
+	Operation string
+	Response  bool
+	Issues    []string
+}
+
+func (e *Error) Error() string {
+	if e.Response {
+		return fmt.Sprintf("%s: response does not match the spec: %s", e.Operation, strings.Join(e.Issues, "; "))
+	}
+	return fmt.Sprintf("%s: %s", e.Operation, strings.Join(e.Issues, "; "))
+}
+
+// ValidateRequest checks the path parameters, query and JSON body of a
+// request to op. Parameters the spec does not list are let through, since
+// firmware accepts more than it documents.
+func (op *Operation) ValidateRequest(path string, q url.Values, body []byte) error {
+	var issues []string
+	pathParams := op.PathParams(path)
+	for _, p := range op.Params {
+		var vals []string
+		switch p.In {
+		case "path":
+			vals = []string{pathParams[p.Name]}
+		case "query":
+			vals = q[p.Name]
+		default:
+			continue
+		}
+		if len(vals) == 0 {
+			if p.Required {
+				issues = append(issues, fmt.Sprintf("%s is required%s", p.Name, p.Schema.allowed()))
+			}
+			continue
+		}
+		for _, v := range vals {
+			if msg := p.Schema.checkString(v); msg != "" {
+				issues = append(issues, fmt.Sprintf("%s %s", p.Name, msg))
+			}
+		}
+	}
+	if op.Body != nil && len(body) > 0 {
+		var v any
+		if err := json.Unmarshal(body, &v); err != nil {
+			issues = append(issues, "body is not JSON: "+err.Error())
+		} else {
+			op.Body.check("body", v, false, &issues)
+		}
+	}
+	if len(issues) > 0 {
+		return &Error{Operation: op.ID, Issues: issues}
+	}
+	return nil
+}
+
+// ValidateResponse checks a JSON response body against the operation's
+// response schema. Unlike requests, fields the spec does not describe are
+// reported, since they show the firmware and the spec have drifted apart.
+func (op *Operation) ValidateResponse(body []byte) error {
+	if op.Response == nil || len(body) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return &Error{Operation: op.ID, Response: true, Issues: []string{"not JSON: " + err.Error()}}
+	}
+	var issues []string
+	op.Response.check("", v, true, &issues)
+	if len(issues) > 0 {
+		return &Error{Operation: op.ID, Response: true, Issues: issues}
+	}
+	return nil
+}
+
+// checkString validates a query or path value and returns what is wrong
+// with it, or "".
+func (s *Schema) checkString(v string) string {
+	if s == nil {
+		return ""
+	}
+	if len(s.OneOf) > 0 {
+		wants := []string{}
+		for _, alt := range s.OneOf {
+			if alt.checkString(v) == "" {
+				return ""
+			}
+			wants = append(wants, alt.describe())
+		}
+		return fmt.Sprintf("%q is not %s", v, strings.Join(wants, " or "))
+	}
+	switch s.Type {
+	case "integer":
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Sprintf("%q is not an integer", v)
+		}
+		if msg := s.checkRange(float64(n)); msg != "" {
+			return msg
+		}
+	case "number":
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Sprintf("%q is not a number", v)
+		}
+		if msg := s.checkRange(f); msg != "" {
+			return msg
+		}
+	case "boolean":
+		if v != "true" && v != "false" {
+			return fmt.Sprintf("%q is not true or false", v)
+		}
+	}
+	if len(s.Enum) > 0 && !s.inEnum(v) {
+		return fmt.Sprintf("%q is not one of %s", v, strings.Join(s.EnumValues(), ", "))
+	}
+	if s.MaxLength != nil && len(v) > *s.MaxLength {
+		return fmt.Sprintf("is longer than %d bytes", *s.MaxLength)
+	}
+	return ""
+}
+
+func (s *Schema) checkRange(f float64) string {
+	if s.Minimum != nil && f < *s.Minimum {
+		return fmt.Sprintf("%v is below the minimum %v", f, *s.Minimum)
+	}
+	if s.Maximum != nil && f > *s.Maximum {
+		return fmt.Sprintf("%v is above the maximum %v", f, *s.Maximum)
+	}
+	return ""
+}
+
+// check validates a decoded JSON value, appending an issue per mismatch.
+func (s *Schema) check(path string, v any, strict bool, issues *[]string) {
+	if s == nil || v == nil {
+		return
+	}
+	if len(s.OneOf) > 0 {
+		for _, alt := range s.OneOf {
+			var sub []string
+			alt.check(path, v, strict, &sub)
+			if len(sub) == 0 {
+				return
+			}
+		}
+		*issues = append(*issues, fmt.Sprintf("%s: %s does not match any allowed form", label(path), compact(v)))
+		return
+	}
+	switch s.Type {
+	case "object":
+		m, ok := v.(map[string]any)
+		if !ok {
+			*issues = append(*issues, fmt.Sprintf("%s: expected object, got %s", label(path), compact(v)))
+			return
+		}
+		for _, name := range s.Required {
+			if _, ok := m[name]; !ok {
+				*issues = append(*issues, fmt.Sprintf("%s: missing", join(path, name)))
+			}
+		}
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			prop, known := s.Properties[k]
+			if !known {
+				if strict && len(s.Properties) > 0 {
+					*issues = append(*issues, fmt.Sprintf("%s: not in the spec", join(path, k)))
+				}
+				continue
+			}
+			prop.check(join(path, k), m[k], strict, issues)
+		}
+		return
+	case "array":
+		items, ok := v.([]any)
+		if !ok {
+			*issues = append(*issues, fmt.Sprintf("%s: expected array, got %s", label(path), compact(v)))
+			return
+		}
+		for i, item := range items {
+			s.Items.check(fmt.Sprintf("%s[%d]", path, i), item, strict, issues)
+		}
+		return
+	case "integer", "number":
+		f, ok := v.(float64)
+		if !ok || (s.Type == "integer" && f != math.Trunc(f)) {
+			*issues = append(*issues, fmt.Sprintf("%s: expected %s, got %s", label(path), s.Type, compact(v)))
+			return
+		}
+		if msg := s.checkRange(f); msg != "" {
+			*issues = append(*issues, fmt.Sprintf("%s: %s", label(path), msg))
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			*issues = append(*issues, fmt.Sprintf("%s: expected boolean, got %s", label(path), compact(v)))
+			return
+		}
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			*issues = append(*issues, fmt.Sprintf("%s: expected string, got %s", label(path), compact(v)))
+			return
+		}
+		if s.MaxLength != nil && len(str) > *s.MaxLength {
+			*issues = append(*issues, fmt.Sprintf("%s: longer than %d bytes", label(path), *s.MaxLength))
+		}
+	}
+	if len(s.Enum) > 0 && !s.inEnum(v) {
+		*issues = append(*issues, fmt.Sprintf("%s: %s is not one of %s", label(path), compact(v), strings.Join(s.EnumValues(), ", ")))
+	}
+}
+
+func (s *Schema) inEnum(v any) bool {
+	want := fmt.Sprint(v)
+	for _, e := range s.Enum {
+		if fmt.Sprint(e) == want {
+			return true
+		}
+	}
+	return false
+}
+
+// EnumValues returns the allowed values as strings.
+func (s *Schema) EnumValues() []string {
+	if s == nil {
+		return nil
+	}
+	out := make([]string, 0, len(s.Enum))
+	for _, e := range s.Enum {
+		out = append(out, fmt.Sprint(e))
+	}
+	for _, alt := range s.OneOf {
+		out = append(out, alt.EnumValues()...)
+	}
+	return out
+}
+
+// Coerce rewrites a path or query value written loosely into the form s
+// accepts: on/off and yes/no for booleans, 40.0 or +5 for integers, and enum
+// values in another case (FM for fm). Values s already accepts, and values
+// that cannot be read either way, are returned as given.
+func (s *Schema) Coerce(v string) string {
+	if s == nil || s.checkString(v) == "" {
+		return v
+	}
+	for _, alt := range s.OneOf {
+		if c := alt.Coerce(v); alt.checkString(c) == "" {
+			return c
+		}
+	}
+	switch s.Type {
+	case "boolean":
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "on", "yes", "1", "true":
+			return "true"
+		case "off", "no", "0", "false":
+			return "false"
+		}
+	case "integer":
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err == nil && f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+			v = strconv.FormatInt(int64(f), 10)
+		}
+	}
+	for _, e := range s.EnumValues() {
+		if strings.EqualFold(e, strings.TrimSpace(v)) {
+			return e
+		}
+	}
+	return v
+}
+
+// describe names the values s accepts, e.g. "an integer" or "one of up, down".
+func (s *Schema) describe() string {
+	if len(s.Enum) > 0 {
+		return "one of " + strings.Join(s.EnumValues(), ", ")
+	}
+	switch s.Type {
+	case "integer":
+		return "an integer"
+	case "boolean":
+		return "true or false"
+	case "":
+		return "a value"
+	default:
+		return "a " + s.Type
+	}
+}
+
+func (s *Schema) allowed() string {
+	if s == nil || (len(s.Enum) == 0 && len(s.OneOf) == 0) {
+		return ""
+	}
+	if len(s.OneOf) > 0 {
+		wants := make([]string, len(s.OneOf))
+		for i, alt := range s.OneOf {
+			wants[i] = alt.describe()
+		}
+		return " (" + strings.Join(wants, " or ") + ")"
+	}
+	return " (" + s.describe() + ")"
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func label(path string) string {
+	if path == "" {
+		return "response"
+	}
+	return path
+}
+
+func compact(v any) string {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(out)
+}
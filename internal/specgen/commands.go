@@ -0,0 +1,417 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/amannm/yxc/internal/spec"
+)
+
+// groups maps the spec's tags to the command groups of the CLI, in the
+// order generatedCommands lists them.
+var groups = []struct{ tag, name string }{
+	{"System", "system"},
+	{"Zone", "zone"},
+	{"Tuner", "tuner"},
+	{"NetUSB", "netusb"},
+	{"CD", "cd"},
+	{"Clock", "clock"},
+	{"Distribution", "dist"},
+}
+
+// globalFlags are the root command's persistent flags, as registered in
+// cmd/root.go. A parameter with one of these names gets its flag prefixed
+// with the command group (--netusb-timeout), so that it does not shadow the
+// global flag.
+var globalFlags = map[string]bool{
+	"config": true, "device": true, "devices": true, "all": true, "host": true, "base-url": true,
+	"api-prefix": true, "zone": true, "timeout": true, "retries": true, "retry-delay": true,
+	"retry-max-delay": true, "firmware-wait": true, "retry-writes": true, "request-gap": true,
+	"max-in-flight": true, "lock-dir": true, "auth": true, "proxy": true, "header": true,
+	"dry-run": true, "record": true, "replay": true, "no-validate": true, "features-ttl": true,
+	"features-dir": true, "strict": true, "format": true, "field": true, "columns": true,
+	"sort-by": true, "verbose": true, "quiet": true, "no-color": true,
+}
+
+// commandName is where an operation's command sits under its group, e.g.
+// ["preset", "store"], and the parameter given as its positional argument,
+// if any.
+type commandName struct {
+	path []string
+	arg  string
+}
+
+// command is one generated cobra command: a leaf running op, or a parent
+// of the leaves that share the first word of their path.
+type command struct {
+	op       *spec.Operation
+	name     string
+	ctor     string
+	arg      *commandFlag
+	flags    []commandFlag
+	children []*command
+}
+
+// maxListedEnum is the most enum values spelled out in a usage line or a
+// Use; longer lists, such as the documented input IDs, are left to
+// validation.
+const maxListedEnum = 16
+
+type commandFlag struct {
+	name     string
+	field    string
+	kind     string
+	usage    string
+	def      string
+	enum     []string
+	required bool
+}
+
+func genCommands(s *spec.Spec, handWritten map[string]bool, names map[string]commandName) ([]byte, error) {
+	byGroup := map[string][]*command{}
+	for _, g := range groups {
+		var ops []*spec.Operation
+		for _, op := range s.Operations {
+			if op.Tag == g.tag && !handWritten[op.ID] {
+				ops = append(ops, op)
+			}
+		}
+		derived := commandNames(ops)
+		seen := map[string]string{}
+		parents := map[string]*command{}
+		for _, op := range ops {
+			n, ok := names[op.ID]
+			if !ok {
+				n = derived[op.ID]
+			}
+			full := strings.Join(n.path, " ")
+			if other, dup := seen[full]; dup {
+				return nil, fmt.Errorf("%s and %s both map to %s %s", other, op.ID, g.name, full)
+			}
+			seen[full] = op.ID
+			c, err := leafCommand(g.name, op, n)
+			if err != nil {
+				return nil, err
+			}
+			if len(n.path) == 1 {
+				byGroup[g.name] = append(byGroup[g.name], c)
+				continue
+			}
+			p := parents[n.path[0]]
+			if p == nil {
+				p = &command{name: n.path[0], ctor: "new" + pascal(g.name) + pascal(n.path[0]) + "Cmd"}
+				parents[n.path[0]] = p
+				byGroup[g.name] = append(byGroup[g.name], p)
+			}
+			p.children = append(p.children, c)
+		}
+		for _, c := range byGroup[g.name] {
+			if c.op != nil && parents[c.name] != nil {
+				return nil, fmt.Errorf("%s %s is both a command and a parent of commands", g.name, c.name)
+			}
+		}
+	}
+
+	b := newBuffer()
+	b.WriteString("package cmd\n\nimport \"github.com/spf13/cobra\"\n\n")
+	b.WriteString("// generatedCommands returns the spec-derived commands for a command group.\n")
+	b.WriteString("func generatedCommands(group string) []*cobra.Command {\n\tswitch group {\n")
+	for _, g := range groups {
+		cmds := byGroup[g.name]
+		if len(cmds) == 0 {
+			continue
+		}
+		fmt.Fprintf(b, "\tcase %q:\n\t\treturn []*cobra.Command{\n", g.name)
+		for _, c := range cmds {
+			fmt.Fprintf(b, "\t\t\t%s(),\n", c.ctor)
+		}
+		b.WriteString("\t\t}\n")
+	}
+	b.WriteString("\t}\n\treturn nil\n}\n")
+	for _, g := range groups {
+		for _, c := range byGroup[g.name] {
+			if c.op == nil {
+				writeParent(b, c)
+				for _, child := range c.children {
+					writeCommand(b, child)
+				}
+				continue
+			}
+			writeCommand(b, c)
+		}
+	}
+	return b.Bytes(), nil
+}
+
+func leafCommand(group string, op *spec.Operation, n commandName) (*command, error) {
+	c := &command{op: op, name: n.path[len(n.path)-1], ctor: "new" + pascal(group)}
+	for _, w := range n.path {
+		c.ctor += pascal(w)
+	}
+	c.ctor += "Cmd"
+	for _, f := range commandFlags(op) {
+		if f.field == n.arg {
+			f.name = ""
+			c.arg = &f
+			continue
+		}
+		if globalFlags[f.name] {
+			f.name = group + "-" + f.name
+		}
+		c.flags = append(c.flags, f)
+	}
+	if n.arg != "" && c.arg == nil {
+		return nil, fmt.Errorf("%s has no parameter %s", op.ID, n.arg)
+	}
+	return c, nil
+}
+
+// commandNames names each operation after its endpoint without the get/set
+// verb, e.g. getDeviceInfo becomes device-info. A noun with both a getter
+// and a setter becomes a parent with get and set commands, the way
+// system name get and system name set are laid out.
+func commandNames(ops []*spec.Operation) map[string]commandName {
+	endpoint := func(op *spec.Operation) string {
+		return op.Path[strings.LastIndex(op.Path, "/")+1:]
+	}
+	getters, setters := map[string]bool{}, map[string]bool{}
+	for _, op := range ops {
+		if noun, ok := strings.CutPrefix(endpoint(op), "get"); ok {
+			getters[noun] = true
+		}
+		if noun, ok := strings.CutPrefix(endpoint(op), "set"); ok {
+			setters[noun] = true
+		}
+	}
+	out := map[string]commandName{}
+	for _, op := range ops {
+		e := endpoint(op)
+		path := []string{kebab(e)}
+		for _, verb := range []string{"get", "set"} {
+			if noun, ok := strings.CutPrefix(e, verb); ok {
+				path = []string{kebab(noun)}
+				if getters[noun] && setters[noun] {
+					path = append(path, verb)
+				}
+			}
+		}
+		out[op.ID] = commandName{path: path}
+	}
+	return out
+}
+
+// commandFlags makes a flag for every query parameter and every top-level
+// body field. Zone parameters come from the global --zone.
+func commandFlags(op *spec.Operation) []commandFlag {
+	var flags []commandFlag
+	for _, p := range op.Params {
+		if p.Name == "zone" {
+			continue
+		}
+		flags = append(flags, newFlag(p.Name, p.Schema, p.Description, p.Required))
+	}
+	if op.Body != nil {
+		names := make([]string, 0, len(op.Body.Properties))
+		for k := range op.Body.Properties {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		for _, k := range names {
+			flags = append(flags, newFlag(k, op.Body.Properties[k], op.Body.Properties[k].Description, false))
+		}
+	}
+	return flags
+}
+
+func newFlag(field string, s *spec.Schema, desc string, required bool) commandFlag {
+	f := commandFlag{name: kebab(field), field: field, kind: "String", required: required}
+	if s != nil {
+		switch s.Type {
+		case "integer":
+			f.kind = "Int"
+		case "number":
+			f.kind = "Float64"
+		case "boolean":
+			f.kind = "Bool"
+		}
+		if len(s.Enum) > 0 || len(s.OneOf) > 0 {
+			f.enum = s.EnumValues()
+			if len(s.OneOf) > 0 && len(f.enum) < countAlternatives(s) {
+				f.enum = nil
+			}
+		}
+		if s.Default != nil {
+			f.def = fmt.Sprint(s.Default)
+		}
+		if desc == "" {
+			desc = s.Description
+		}
+	}
+	usage := oneLine(desc)
+	if usage == "" {
+		usage = phrase(field)
+	}
+	switch {
+	case len(f.enum) > 0 && len(f.enum) <= maxListedEnum:
+		usage += ": " + strings.Join(f.enum, "|")
+	case s != nil && (s.Minimum != nil || s.Maximum != nil):
+		usage += " (" + bound(s.Minimum) + ".." + bound(s.Maximum) + ")"
+	case s != nil && (s.Type == "object" || s.Type == "array"):
+		usage += " (JSON)"
+	}
+	f.usage = usage
+	return f
+}
+
+// countAlternatives counts the oneOf branches that are plain enums; a oneOf
+// with any other branch (e.g. an integer range) is not a closed set.
+func countAlternatives(s *spec.Schema) int {
+	n := 0
+	for _, alt := range s.OneOf {
+		if len(alt.Enum) == 0 {
+			return len(s.OneOf) + 1
+		}
+		n += len(alt.Enum)
+	}
+	return n
+}
+
+// phrase spells a field name as words, e.g. ip_address becomes
+// "IP address".
+func phrase(field string) string {
+	ws := words(field)
+	for i, w := range ws {
+		switch {
+		case initialisms[w]:
+			ws[i] = strings.ToUpper(w)
+		case i == 0:
+			ws[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+	}
+	return strings.Join(ws, " ")
+}
+
+// pascal joins the words of s with capitals, e.g. ir-sensor becomes
+// IrSensor, the way hand-written command constructors are named.
+func pascal(s string) string {
+	var b strings.Builder
+	for _, w := range words(s) {
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	return b.String()
+}
+
+func bound(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f, 'f', -1, 64)
+}
+
+// writeParent writes a command that only groups its children. Its short
+// description lists theirs.
+func writeParent(b *bytes.Buffer, c *command) {
+	shorts := make([]string, len(c.children))
+	for i, child := range c.children {
+		shorts[i] = child.op.Summary
+		if i > 0 {
+			shorts[i] = lowerFirst(shorts[i])
+		}
+	}
+	w := func(format string, args ...any) { fmt.Fprintf(b, format, args...) }
+	w("\nfunc %s() *cobra.Command {\n", c.ctor)
+	w("\tcmd := &cobra.Command{\n")
+	w("\t\tUse:   %q,\n", c.name)
+	w("\t\tShort: %q,\n", strings.Join(shorts, ", "))
+	w("\t}\n\n\tcmd.AddCommand(\n")
+	for _, child := range c.children {
+		w("\t\t%s(),\n", child.ctor)
+	}
+	w("\t)\n\n\treturn cmd\n}\n")
+}
+
+// lowerFirst lower-cases the first letter of a sentence that goes on after
+// a comma, unless it starts an initialism such as DAB.
+func lowerFirst(s string) string {
+	r := []rune(s)
+	if len(r) > 1 && unicode.IsUpper(r[1]) {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+func writeCommand(b *bytes.Buffer, c *command) {
+	w := func(format string, args ...any) { fmt.Fprintf(b, format, args...) }
+	use := c.name
+	if c.arg != nil {
+		if len(c.arg.enum) > 0 && len(c.arg.enum) <= maxListedEnum {
+			use += " <" + strings.Join(c.arg.enum, "|") + ">"
+		} else {
+			use += " <" + kebab(c.arg.field) + ">"
+		}
+	}
+	w("\nfunc %s() *cobra.Command {\n", c.ctor)
+	w("\tcmd := &cobra.Command{\n")
+	w("\t\tUse:   %q,\n", use)
+	w("\t\tShort: %q,\n", c.op.Summary)
+	if c.op.Description != "" && c.op.Description != c.op.Summary {
+		w("\t\tLong:  %q,\n", c.op.Description+"\n\nOperation: "+c.op.ID)
+	} else {
+		w("\t\tLong:  %q,\n", c.op.Summary+"\n\nOperation: "+c.op.ID)
+	}
+	w("\t\tAnnotations: map[string]string{\"operation\": %q},\n", c.op.ID)
+	if c.arg != nil {
+		w("\t\tArgs: cobra.ExactArgs(1),\n")
+		if len(c.arg.enum) > 0 {
+			w("\t\tValidArgs: %s,\n", quoteList(c.arg.enum))
+		}
+	} else {
+		w("\t\tArgs: cobra.NoArgs,\n")
+	}
+	w("\t\tRunE: runOperation(%q", c.op.ID)
+	if c.arg != nil {
+		w(",\n\t\t\toperationFlag{field: %q, arg: true%s}", c.arg.field, enumField(c.arg.enum))
+	}
+	for _, f := range c.flags {
+		w(",\n\t\t\toperationFlag{name: %q, field: %q%s}", f.name, f.field, enumField(f.enum))
+	}
+	w("),\n\t}\n\n")
+	for _, f := range c.flags {
+		switch f.kind {
+		case "Int":
+			def := f.def
+			if def == "" {
+				def = "0"
+			}
+			w("\tcmd.Flags().Int(%q, %s, %q)\n", f.name, def, f.usage)
+		case "Float64":
+			def := f.def
+			if def == "" {
+				def = "0"
+			}
+			w("\tcmd.Flags().Float64(%q, %s, %q)\n", f.name, def, f.usage)
+		case "Bool":
+			w("\tcmd.Flags().Bool(%q, %t, %q)\n", f.name, f.def == "true", f.usage)
+		default:
+			w("\tcmd.Flags().String(%q, %q, %q)\n", f.name, f.def, f.usage)
+		}
+		if f.required {
+			w("\t_ = cmd.MarkFlagRequired(%q)\n", f.name)
+		}
+	}
+	if c.op.Body != nil {
+		w("\tcmd.Flags().String(\"data\", \"\", \"JSON request body, instead of the field flags\")\n")
+	}
+	w("\n\treturn cmd\n}\n")
+}
+
+func enumField(enum []string) string {
+	if len(enum) == 0 {
+		return ""
+	}
+	return ", enum: " + quoteList(enum)
+}
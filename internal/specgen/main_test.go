@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/amannm/yxc/internal/spec"
+)
+
+// TestGeneratedUpToDate fails when the spec, the overrides or the names
+// changed without running go generate.
+func TestGeneratedUpToDate(t *testing.T) {
+	data, err := os.ReadFile("../../reference/yamaha-extended-control-api.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := spec.Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handWritten, err := readOverrides("overrides.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := readNames("names.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkOperations(s, handWritten, names); err != nil {
+		t.Fatal(err)
+	}
+	cmds, err := genCommands(s, handWritten, names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, src := range map[string][]byte{
+		"../../cmd/operations_gen.go":  cmds,
+		"../../pkg/yxc/api/api_gen.go": genTypes(s),
+	} {
+		want, err := format.Source(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is stale; run go generate", path)
+		}
+	}
+}
+
+func TestNames(t *testing.T) {
+	for in, want := range map[string][]string{
+		"getNetUsbPlayInfo": {"get", "net", "usb", "play", "info"},
+		"list_id":           {"list", "id"},
+		"setHdmiOut1":       {"set", "hdmi", "out1"},
+		"DABService":        {"dab", "service"},
+	} {
+		if got := words(in); !slices.Equal(got, want) {
+			t.Errorf("words(%q) = %q, want %q", in, got, want)
+		}
+	}
+	if got := goName("device_id"); got != "DeviceID" {
+		t.Errorf("goName(device_id) = %q", got)
+	}
+	if got := phrase("ip_address"); got != "IP address" {
+		t.Errorf("phrase(ip_address) = %q", got)
+	}
+}
+
+func TestCommandNames(t *testing.T) {
+	data, err := os.ReadFile("../../reference/yamaha-extended-control-api.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := spec.Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ops []*spec.Operation
+	for _, op := range s.Operations {
+		if op.Tag == "System" {
+			ops = append(ops, op)
+		}
+	}
+	names := commandNames(ops)
+	for id, want := range map[string]string{
+		"getDeviceInfo":          "device-info",
+		"getNetworkStandby":      "network-standby get",
+		"setNetworkStandby":      "network-standby set",
+		"setIrSensor":            "ir-sensor",
+		"connectBluetoothDevice": "connect-bluetooth-device",
+	} {
+		if got := strings.Join(names[id].path, " "); got != want {
+			t.Errorf("%s = %q, want %q", id, got, want)
+		}
+	}
+
+	overrides, err := readNames("names.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := overrides["setZonePower"]; !slices.Equal(n.path, []string{"power"}) || n.arg != "power" {
+		t.Errorf("setZonePower = %+v", n)
+	}
+	if n := overrides["storeNetUsbPreset"]; !slices.Equal(n.path, []string{"preset", "store"}) || n.arg != "" {
+		t.Errorf("storeNetUsbPreset = %+v", n)
+	}
+}
+
+// TestFlagUsage fails when a generated flag's help only repeats a one-word
+// name such as "Enable" or "Num"; describe the parameter in the spec
+// instead. Listed enum values describe a flag well enough.
+func TestFlagUsage(t *testing.T) {
+	data, err := os.ReadFile("../../reference/yamaha-extended-control-api.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := spec.Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handWritten, err := readOverrides("overrides.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, op := range s.Operations {
+		if handWritten[op.ID] {
+			continue
+		}
+		for _, f := range commandFlags(op) {
+			name := phrase(f.field)
+			if len(f.enum) > 0 || strings.Contains(name, " ") {
+				continue
+			}
+			if usage, _, _ := strings.Cut(f.usage, " ("); usage == name {
+				t.Errorf("%s --%s: usage %q only names the flag", op.ID, f.name, f.usage)
+			}
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/amannm/yxc/internal/spec"
+)
+
+// typeGen names and emits Go types for schemas. Component schemas keep their
+// component name; inline ones are named after where they appear.
+type typeGen struct {
+	names map[*spec.Schema]string
+	taken map[string]bool
+	out   bytes.Buffer
+}
+
+func genTypes(s *spec.Spec) []byte {
+	g := &typeGen{names: map[*spec.Schema]string{}, taken: map[string]bool{}}
+	components := map[string]*spec.Schema{}
+	for _, op := range s.Operations {
+		collectComponents(op.Body, components, map[*spec.Schema]bool{})
+		collectComponents(op.Response, components, map[*spec.Schema]bool{})
+		for _, p := range op.Params {
+			collectComponents(p.Schema, components, map[*spec.Schema]bool{})
+		}
+	}
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		doc := components[name].Description
+		if oneLine(doc) == "" {
+			doc = fmt.Sprintf("%s is the %s schema of the spec.", goName(name), name)
+		}
+		g.named(components[name], goName(name), doc)
+	}
+	for _, op := range s.Operations {
+		base := goName(op.ID)
+		for _, p := range op.Params {
+			if p.Schema != nil && p.Schema.Name == "" && (len(p.Schema.Enum) > 0) {
+				g.named(p.Schema, base+goName(p.Name), fmt.Sprintf("%s is the %s parameter of %s.", base+goName(p.Name), p.Name, op.ID))
+			}
+		}
+		if op.Body != nil && op.Body.Name == "" {
+			g.named(op.Body, base+"Request", fmt.Sprintf("%sRequest is the request body of %s.", base, op.ID))
+		}
+		if op.Response != nil && op.Response.Name == "" {
+			g.named(op.Response, base+"Response", fmt.Sprintf("%sResponse is the response of %s.", base, op.ID))
+		}
+	}
+
+	b := newBuffer()
+	b.WriteString("// Package api holds Go types for the enums, request bodies and responses\n")
+	b.WriteString("// described by the bundled Extended Control API specification.\n")
+	b.WriteString("package api\n")
+	b.Write(g.out.Bytes())
+	return b.Bytes()
+}
+
+func collectComponents(s *spec.Schema, out map[string]*spec.Schema, seen map[*spec.Schema]bool) {
+	if s == nil || seen[s] {
+		return
+	}
+	seen[s] = true
+	if s.Name != "" {
+		out[s.Name] = s
+	}
+	for _, p := range s.Properties {
+		collectComponents(p, out, seen)
+	}
+	collectComponents(s.Items, out, seen)
+	for _, alt := range s.OneOf {
+		collectComponents(alt, out, seen)
+	}
+}
+
+// named emits a declared type for s under name (made unique if needed) and
+// returns the name it got.
+func (g *typeGen) named(s *spec.Schema, name, doc string) string {
+	if n, ok := g.names[s]; ok {
+		return n
+	}
+	base := name
+	for i := 2; g.taken[name]; i++ {
+		name = base + strconv.Itoa(i)
+	}
+	g.taken[name] = true
+	g.names[s] = name
+	doc = docComment(name, doc)
+	switch {
+	case len(s.Enum) > 0:
+		g.enum(s, name, doc)
+	case s.Type == "object" && len(s.Properties) > 0:
+		g.object(s, name, doc)
+	default:
+		fmt.Fprintf(&g.out, "\n%stype %s %s\n", doc, name, g.plainType(s, name, "a "+name))
+	}
+	return name
+}
+
+func (g *typeGen) object(s *spec.Schema, name, doc string) {
+	keys := make([]string, 0, len(s.Properties))
+	for k := range s.Properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	required := map[string]bool{}
+	for _, r := range s.Required {
+		required[r] = true
+	}
+	// Field types are worked out first: they may declare further types,
+	// which must not land in the middle of this one.
+	types := make([]string, len(keys))
+	for i, k := range keys {
+		types[i] = g.typeOf(s.Properties[k], name+goName(k), fmt.Sprintf("the %s field of %s", k, name))
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n%stype %s struct {\n", doc, name)
+	for i, k := range keys {
+		if d := oneLine(s.Properties[k].Description); d != "" && s.Properties[k].Name == "" {
+			fmt.Fprintf(&b, "\t// %s\n", d)
+		}
+		typ, tag := types[i], k
+		if !required[k] {
+			typ, tag = optional(typ), tag+",omitempty"
+		}
+		fmt.Fprintf(&b, "\t%s %s `json:%q`\n", fieldName(k), typ, tag)
+	}
+	b.WriteString("}\n")
+	g.out.WriteString(b.String())
+}
+
+// optional is the type of a field that may be left out. Scalars and structs
+// become pointers, so that omitempty drops only an unset field and false, 0
+// and "" are still sent; slices, maps and any are nil when unset already.
+func optional(typ string) string {
+	if typ == "any" || strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "map[") {
+		return typ
+	}
+	return "*" + typ
+}
+
+// typeOf returns the Go type for a field or element schema, declaring a
+// named type when it needs one. where describes the schema's place for the
+// doc comment when it has no description.
+func (g *typeGen) typeOf(s *spec.Schema, name, where string) string {
+	if s == nil {
+		return "any"
+	}
+	if n, ok := g.names[s]; ok {
+		return n
+	}
+	if s.Name != "" || len(s.Enum) > 0 || (s.Type == "object" && len(s.Properties) > 0) {
+		doc := s.Description
+		if oneLine(doc) == "" {
+			doc = where
+		}
+		if s.Name != "" {
+			name = goName(s.Name)
+		}
+		return g.named(s, name, doc)
+	}
+	return g.plainType(s, name, where)
+}
+
+// plainType is the Go type for a schema that needs no declaration of its
+// own.
+func (g *typeGen) plainType(s *spec.Schema, name, where string) string {
+	if len(s.OneOf) > 0 {
+		return "any"
+	}
+	switch s.Type {
+	case "string":
+		return "string"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		return "[]" + g.typeOf(s.Items, name+"Item", "an element of "+where)
+	case "object":
+		return "map[string]any"
+	}
+	return "any"
+}
+
+func (g *typeGen) enum(s *spec.Schema, name, doc string) {
+	base := "string"
+	if s.Type == "integer" {
+		base = "int"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n%stype %s %s\n\nconst (\n", doc, name, base)
+	consts := make([]string, 0, len(s.Enum))
+	used := map[string]bool{}
+	for _, v := range s.EnumValues() {
+		c := name + goName(v)
+		if c == name || used[c] {
+			c = name + "Value" + strconv.Itoa(len(consts))
+		}
+		used[c] = true
+		consts = append(consts, c)
+		if base == "int" {
+			fmt.Fprintf(&b, "\t%s %s = %s\n", c, name, v)
+		} else {
+			fmt.Fprintf(&b, "\t%s %s = %q\n", c, name, v)
+		}
+	}
+	b.WriteString(")\n")
+	fmt.Fprintf(&b, "\n// Values returns every %s the spec allows.\nfunc (%s) Values() []%s {\n\treturn []%s{%s}\n}\n",
+		name, name, name, name, strings.Join(consts, ", "))
+	fmt.Fprintf(&b, "\n// Valid reports whether v is one of the values the spec allows.\nfunc (v %s) Valid() bool {\n\tswitch v {\n\tcase %s:\n\t\treturn true\n\t}\n\treturn false\n}\n",
+		name, strings.Join(consts, ", "))
+	g.out.WriteString(b.String())
+}
+
+// fieldName is goName for JSON keys, which may start with a digit.
+func fieldName(k string) string {
+	n := goName(k)
+	if n == "" || (n[0] >= '0' && n[0] <= '9') {
+		n = "X" + n
+	}
+	return n
+}
+
+// docComment turns a description into a Go doc comment that starts with
+// the type's name.
+func docComment(name, desc string) string {
+	desc = oneLine(desc)
+	if desc == "" {
+		return ""
+	}
+	desc = strings.TrimRight(desc, ":.")
+	if !strings.HasPrefix(desc, name+" ") {
+		desc = name + " is " + strings.ToLower(desc[:1]) + desc[1:]
+	}
+	desc += "."
+	return "// " + desc + "\n"
+}
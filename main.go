@@ -1,3 +1,5 @@
+//go:generate go run ./internal/specgen
+
 package main
 
 import "github.com/amannm/yxc/cmd"
@@ -0,0 +1,2469 @@
+// Code generated by specgen from reference/yamaha-extended-control-api.yaml; DO NOT EDIT.
+
+// Package api holds Go types for the enums, request bodies and responses
+// described by the bundled Extended Control API specification.
+package api
+
+// AlarmSettingsMode is the mode field of AlarmSettings.
+type AlarmSettingsMode string
+
+const (
+	AlarmSettingsModeOneday AlarmSettingsMode = "oneday"
+	AlarmSettingsModeWeekly AlarmSettingsMode = "weekly"
+)
+
+// Values returns every AlarmSettingsMode the spec allows.
+func (AlarmSettingsMode) Values() []AlarmSettingsMode {
+	return []AlarmSettingsMode{AlarmSettingsModeOneday, AlarmSettingsModeWeekly}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v AlarmSettingsMode) Valid() bool {
+	switch v {
+	case AlarmSettingsModeOneday, AlarmSettingsModeWeekly:
+		return true
+	}
+	return false
+}
+
+// AlarmSettings is the AlarmSettings schema of the spec.
+type AlarmSettings struct {
+	AlarmOn      *bool              `json:"alarm_on,omitempty"`
+	Detail       map[string]any     `json:"detail,omitempty"`
+	FadeInterval *int               `json:"fade_interval,omitempty"`
+	FadeType     *int               `json:"fade_type,omitempty"`
+	Mode         *AlarmSettingsMode `json:"mode,omitempty"`
+	Repeat       *bool              `json:"repeat,omitempty"`
+	Volume       *int               `json:"volume,omitempty"`
+}
+
+// ResponseCode is response code.
+type ResponseCode int
+
+// BasicResponse is the BasicResponse schema of the spec.
+type BasicResponse struct {
+	ResponseCode ResponseCode `json:"response_code"`
+}
+
+// BluetoothInfoResponseBluetoothDeviceType is the type field of BluetoothInfoResponseBluetoothDevice.
+type BluetoothInfoResponseBluetoothDeviceType string
+
+const (
+	BluetoothInfoResponseBluetoothDeviceTypeLoudspeaker     BluetoothInfoResponseBluetoothDeviceType = "loudspeaker"
+	BluetoothInfoResponseBluetoothDeviceTypeHeadphone       BluetoothInfoResponseBluetoothDeviceType = "headphone"
+	BluetoothInfoResponseBluetoothDeviceTypeHandsfreeDevice BluetoothInfoResponseBluetoothDeviceType = "handsfree_device"
+	BluetoothInfoResponseBluetoothDeviceTypeUnknown         BluetoothInfoResponseBluetoothDeviceType = "unknown"
+)
+
+// Values returns every BluetoothInfoResponseBluetoothDeviceType the spec allows.
+func (BluetoothInfoResponseBluetoothDeviceType) Values() []BluetoothInfoResponseBluetoothDeviceType {
+	return []BluetoothInfoResponseBluetoothDeviceType{BluetoothInfoResponseBluetoothDeviceTypeLoudspeaker, BluetoothInfoResponseBluetoothDeviceTypeHeadphone, BluetoothInfoResponseBluetoothDeviceTypeHandsfreeDevice, BluetoothInfoResponseBluetoothDeviceTypeUnknown}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v BluetoothInfoResponseBluetoothDeviceType) Valid() bool {
+	switch v {
+	case BluetoothInfoResponseBluetoothDeviceTypeLoudspeaker, BluetoothInfoResponseBluetoothDeviceTypeHeadphone, BluetoothInfoResponseBluetoothDeviceTypeHandsfreeDevice, BluetoothInfoResponseBluetoothDeviceTypeUnknown:
+		return true
+	}
+	return false
+}
+
+// BluetoothInfoResponseBluetoothDevice is the bluetooth_device field of BluetoothInfoResponse.
+type BluetoothInfoResponseBluetoothDevice struct {
+	Address   *string                                   `json:"address,omitempty"`
+	Connected *bool                                     `json:"connected,omitempty"`
+	Name      *string                                   `json:"name,omitempty"`
+	Type      *BluetoothInfoResponseBluetoothDeviceType `json:"type,omitempty"`
+}
+
+// BluetoothInfoResponse is the BluetoothInfoResponse schema of the spec.
+type BluetoothInfoResponse struct {
+	BluetoothDevice    *BluetoothInfoResponseBluetoothDevice `json:"bluetooth_device,omitempty"`
+	BluetoothStandby   *bool                                 `json:"bluetooth_standby,omitempty"`
+	BluetoothTXSetting *bool                                 `json:"bluetooth_tx_setting,omitempty"`
+	ResponseCode       *ResponseCode                         `json:"response_code,omitempty"`
+}
+
+// CDPlayInfoResponseDeviceStatus is the device_status field of CDPlayInfoResponse.
+type CDPlayInfoResponseDeviceStatus string
+
+const (
+	CDPlayInfoResponseDeviceStatusOpen     CDPlayInfoResponseDeviceStatus = "open"
+	CDPlayInfoResponseDeviceStatusClose    CDPlayInfoResponseDeviceStatus = "close"
+	CDPlayInfoResponseDeviceStatusReady    CDPlayInfoResponseDeviceStatus = "ready"
+	CDPlayInfoResponseDeviceStatusNotReady CDPlayInfoResponseDeviceStatus = "not_ready"
+)
+
+// Values returns every CDPlayInfoResponseDeviceStatus the spec allows.
+func (CDPlayInfoResponseDeviceStatus) Values() []CDPlayInfoResponseDeviceStatus {
+	return []CDPlayInfoResponseDeviceStatus{CDPlayInfoResponseDeviceStatusOpen, CDPlayInfoResponseDeviceStatusClose, CDPlayInfoResponseDeviceStatusReady, CDPlayInfoResponseDeviceStatusNotReady}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v CDPlayInfoResponseDeviceStatus) Valid() bool {
+	switch v {
+	case CDPlayInfoResponseDeviceStatusOpen, CDPlayInfoResponseDeviceStatusClose, CDPlayInfoResponseDeviceStatusReady, CDPlayInfoResponseDeviceStatusNotReady:
+		return true
+	}
+	return false
+}
+
+// CDPlayInfoResponsePlayback is the playback field of CDPlayInfoResponse.
+type CDPlayInfoResponsePlayback string
+
+const (
+	CDPlayInfoResponsePlaybackPlay        CDPlayInfoResponsePlayback = "play"
+	CDPlayInfoResponsePlaybackStop        CDPlayInfoResponsePlayback = "stop"
+	CDPlayInfoResponsePlaybackPause       CDPlayInfoResponsePlayback = "pause"
+	CDPlayInfoResponsePlaybackFastReverse CDPlayInfoResponsePlayback = "fast_reverse"
+	CDPlayInfoResponsePlaybackFastForward CDPlayInfoResponsePlayback = "fast_forward"
+)
+
+// Values returns every CDPlayInfoResponsePlayback the spec allows.
+func (CDPlayInfoResponsePlayback) Values() []CDPlayInfoResponsePlayback {
+	return []CDPlayInfoResponsePlayback{CDPlayInfoResponsePlaybackPlay, CDPlayInfoResponsePlaybackStop, CDPlayInfoResponsePlaybackPause, CDPlayInfoResponsePlaybackFastReverse, CDPlayInfoResponsePlaybackFastForward}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v CDPlayInfoResponsePlayback) Valid() bool {
+	switch v {
+	case CDPlayInfoResponsePlaybackPlay, CDPlayInfoResponsePlaybackStop, CDPlayInfoResponsePlaybackPause, CDPlayInfoResponsePlaybackFastReverse, CDPlayInfoResponsePlaybackFastForward:
+		return true
+	}
+	return false
+}
+
+// CDPlayInfoResponseRepeat is the repeat field of CDPlayInfoResponse.
+type CDPlayInfoResponseRepeat string
+
+const (
+	CDPlayInfoResponseRepeatOff    CDPlayInfoResponseRepeat = "off"
+	CDPlayInfoResponseRepeatOne    CDPlayInfoResponseRepeat = "one"
+	CDPlayInfoResponseRepeatAll    CDPlayInfoResponseRepeat = "all"
+	CDPlayInfoResponseRepeatFolder CDPlayInfoResponseRepeat = "folder"
+	CDPlayInfoResponseRepeatAB     CDPlayInfoResponseRepeat = "a-b"
+)
+
+// Values returns every CDPlayInfoResponseRepeat the spec allows.
+func (CDPlayInfoResponseRepeat) Values() []CDPlayInfoResponseRepeat {
+	return []CDPlayInfoResponseRepeat{CDPlayInfoResponseRepeatOff, CDPlayInfoResponseRepeatOne, CDPlayInfoResponseRepeatAll, CDPlayInfoResponseRepeatFolder, CDPlayInfoResponseRepeatAB}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v CDPlayInfoResponseRepeat) Valid() bool {
+	switch v {
+	case CDPlayInfoResponseRepeatOff, CDPlayInfoResponseRepeatOne, CDPlayInfoResponseRepeatAll, CDPlayInfoResponseRepeatFolder, CDPlayInfoResponseRepeatAB:
+		return true
+	}
+	return false
+}
+
+// CDPlayInfoResponseShuffle is the shuffle field of CDPlayInfoResponse.
+type CDPlayInfoResponseShuffle string
+
+const (
+	CDPlayInfoResponseShuffleOff     CDPlayInfoResponseShuffle = "off"
+	CDPlayInfoResponseShuffleOn      CDPlayInfoResponseShuffle = "on"
+	CDPlayInfoResponseShuffleFolder  CDPlayInfoResponseShuffle = "folder"
+	CDPlayInfoResponseShuffleProgram CDPlayInfoResponseShuffle = "program"
+)
+
+// Values returns every CDPlayInfoResponseShuffle the spec allows.
+func (CDPlayInfoResponseShuffle) Values() []CDPlayInfoResponseShuffle {
+	return []CDPlayInfoResponseShuffle{CDPlayInfoResponseShuffleOff, CDPlayInfoResponseShuffleOn, CDPlayInfoResponseShuffleFolder, CDPlayInfoResponseShuffleProgram}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v CDPlayInfoResponseShuffle) Valid() bool {
+	switch v {
+	case CDPlayInfoResponseShuffleOff, CDPlayInfoResponseShuffleOn, CDPlayInfoResponseShuffleFolder, CDPlayInfoResponseShuffleProgram:
+		return true
+	}
+	return false
+}
+
+// CDPlayInfoResponse is the CdPlayInfoResponse schema of the spec.
+type CDPlayInfoResponse struct {
+	Album            *string                         `json:"album,omitempty"`
+	Artist           *string                         `json:"artist,omitempty"`
+	DeviceStatus     *CDPlayInfoResponseDeviceStatus `json:"device_status,omitempty"`
+	DiscTime         *int                            `json:"disc_time,omitempty"`
+	PlayTime         *int                            `json:"play_time,omitempty"`
+	Playback         *CDPlayInfoResponsePlayback     `json:"playback,omitempty"`
+	Repeat           *CDPlayInfoResponseRepeat       `json:"repeat,omitempty"`
+	RepeatAvailable  []string                        `json:"repeat_available,omitempty"`
+	ResponseCode     *ResponseCode                   `json:"response_code,omitempty"`
+	Shuffle          *CDPlayInfoResponseShuffle      `json:"shuffle,omitempty"`
+	ShuffleAvailable []string                        `json:"shuffle_available,omitempty"`
+	TotalTime        *int                            `json:"total_time,omitempty"`
+	TotalTracks      *int                            `json:"total_tracks,omitempty"`
+	Track            *string                         `json:"track,omitempty"`
+	TrackNumber      *int                            `json:"track_number,omitempty"`
+}
+
+// ClientInfoRequestZoneItem is an element of the zone field of ClientInfoRequest.
+type ClientInfoRequestZoneItem string
+
+const (
+	ClientInfoRequestZoneItemMain  ClientInfoRequestZoneItem = "main"
+	ClientInfoRequestZoneItemZone2 ClientInfoRequestZoneItem = "zone2"
+	ClientInfoRequestZoneItemZone3 ClientInfoRequestZoneItem = "zone3"
+	ClientInfoRequestZoneItemZone4 ClientInfoRequestZoneItem = "zone4"
+)
+
+// Values returns every ClientInfoRequestZoneItem the spec allows.
+func (ClientInfoRequestZoneItem) Values() []ClientInfoRequestZoneItem {
+	return []ClientInfoRequestZoneItem{ClientInfoRequestZoneItemMain, ClientInfoRequestZoneItemZone2, ClientInfoRequestZoneItemZone3, ClientInfoRequestZoneItemZone4}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ClientInfoRequestZoneItem) Valid() bool {
+	switch v {
+	case ClientInfoRequestZoneItemMain, ClientInfoRequestZoneItemZone2, ClientInfoRequestZoneItemZone3, ClientInfoRequestZoneItemZone4:
+		return true
+	}
+	return false
+}
+
+// ClientInfoRequest is the ClientInfoRequest schema of the spec.
+type ClientInfoRequest struct {
+	// Group ID (32-digit hex, empty to cancel)
+	GroupID         string                      `json:"group_id"`
+	ServerIPAddress *string                     `json:"server_ip_address,omitempty"`
+	Zone            []ClientInfoRequestZoneItem `json:"zone,omitempty"`
+}
+
+// ClockSettingsResponseAlarmMode is the mode field of ClockSettingsResponseAlarm.
+type ClockSettingsResponseAlarmMode string
+
+const (
+	ClockSettingsResponseAlarmModeOneday ClockSettingsResponseAlarmMode = "oneday"
+	ClockSettingsResponseAlarmModeWeekly ClockSettingsResponseAlarmMode = "weekly"
+)
+
+// Values returns every ClockSettingsResponseAlarmMode the spec allows.
+func (ClockSettingsResponseAlarmMode) Values() []ClockSettingsResponseAlarmMode {
+	return []ClockSettingsResponseAlarmMode{ClockSettingsResponseAlarmModeOneday, ClockSettingsResponseAlarmModeWeekly}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ClockSettingsResponseAlarmMode) Valid() bool {
+	switch v {
+	case ClockSettingsResponseAlarmModeOneday, ClockSettingsResponseAlarmModeWeekly:
+		return true
+	}
+	return false
+}
+
+// ClockSettingsResponseAlarmOnedayPlaybackType is the playback_type field of ClockSettingsResponseAlarmOneday.
+type ClockSettingsResponseAlarmOnedayPlaybackType string
+
+const (
+	ClockSettingsResponseAlarmOnedayPlaybackTypeResume ClockSettingsResponseAlarmOnedayPlaybackType = "resume"
+	ClockSettingsResponseAlarmOnedayPlaybackTypePreset ClockSettingsResponseAlarmOnedayPlaybackType = "preset"
+)
+
+// Values returns every ClockSettingsResponseAlarmOnedayPlaybackType the spec allows.
+func (ClockSettingsResponseAlarmOnedayPlaybackType) Values() []ClockSettingsResponseAlarmOnedayPlaybackType {
+	return []ClockSettingsResponseAlarmOnedayPlaybackType{ClockSettingsResponseAlarmOnedayPlaybackTypeResume, ClockSettingsResponseAlarmOnedayPlaybackTypePreset}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ClockSettingsResponseAlarmOnedayPlaybackType) Valid() bool {
+	switch v {
+	case ClockSettingsResponseAlarmOnedayPlaybackTypeResume, ClockSettingsResponseAlarmOnedayPlaybackTypePreset:
+		return true
+	}
+	return false
+}
+
+// ClockSettingsResponseAlarmOneday is the oneday field of ClockSettingsResponseAlarm.
+type ClockSettingsResponseAlarmOneday struct {
+	Beep         *bool                                         `json:"beep,omitempty"`
+	Enable       *bool                                         `json:"enable,omitempty"`
+	PlaybackType *ClockSettingsResponseAlarmOnedayPlaybackType `json:"playback_type,omitempty"`
+	Time         *string                                       `json:"time,omitempty"`
+}
+
+// ClockSettingsResponseAlarm is the alarm field of ClockSettingsResponse.
+type ClockSettingsResponseAlarm struct {
+	AlarmOn      *bool                             `json:"alarm_on,omitempty"`
+	FadeInterval *int                              `json:"fade_interval,omitempty"`
+	FadeType     *int                              `json:"fade_type,omitempty"`
+	Mode         *ClockSettingsResponseAlarmMode   `json:"mode,omitempty"`
+	Oneday       *ClockSettingsResponseAlarmOneday `json:"oneday,omitempty"`
+	Repeat       *bool                             `json:"repeat,omitempty"`
+	Volume       *int                              `json:"volume,omitempty"`
+	Weekly       map[string]any                    `json:"weekly,omitempty"`
+}
+
+// ClockSettingsResponseFormat is the format field of ClockSettingsResponse.
+type ClockSettingsResponseFormat string
+
+const (
+	ClockSettingsResponseFormat12h ClockSettingsResponseFormat = "12h"
+	ClockSettingsResponseFormat24h ClockSettingsResponseFormat = "24h"
+)
+
+// Values returns every ClockSettingsResponseFormat the spec allows.
+func (ClockSettingsResponseFormat) Values() []ClockSettingsResponseFormat {
+	return []ClockSettingsResponseFormat{ClockSettingsResponseFormat12h, ClockSettingsResponseFormat24h}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ClockSettingsResponseFormat) Valid() bool {
+	switch v {
+	case ClockSettingsResponseFormat12h, ClockSettingsResponseFormat24h:
+		return true
+	}
+	return false
+}
+
+// ClockSettingsResponse is the ClockSettingsResponse schema of the spec.
+type ClockSettingsResponse struct {
+	Alarm        *ClockSettingsResponseAlarm  `json:"alarm,omitempty"`
+	AutoSync     *bool                        `json:"auto_sync,omitempty"`
+	Format       *ClockSettingsResponseFormat `json:"format,omitempty"`
+	ResponseCode *ResponseCode                `json:"response_code,omitempty"`
+}
+
+// DeviceInfoResponseDestination is the destination field of DeviceInfoResponse.
+type DeviceInfoResponseDestination string
+
+const (
+	DeviceInfoResponseDestinationJ  DeviceInfoResponseDestination = "J"
+	DeviceInfoResponseDestinationU  DeviceInfoResponseDestination = "U"
+	DeviceInfoResponseDestinationC  DeviceInfoResponseDestination = "C"
+	DeviceInfoResponseDestinationR  DeviceInfoResponseDestination = "R"
+	DeviceInfoResponseDestinationB  DeviceInfoResponseDestination = "B"
+	DeviceInfoResponseDestinationG  DeviceInfoResponseDestination = "G"
+	DeviceInfoResponseDestinationBg DeviceInfoResponseDestination = "BG"
+	DeviceInfoResponseDestinationT  DeviceInfoResponseDestination = "T"
+	DeviceInfoResponseDestinationA  DeviceInfoResponseDestination = "A"
+	DeviceInfoResponseDestinationL  DeviceInfoResponseDestination = "L"
+	DeviceInfoResponseDestinationK  DeviceInfoResponseDestination = "K"
+	DeviceInfoResponseDestinationE  DeviceInfoResponseDestination = "E"
+	DeviceInfoResponseDestinationV  DeviceInfoResponseDestination = "V"
+	DeviceInfoResponseDestinationF  DeviceInfoResponseDestination = "F"
+	DeviceInfoResponseDestinationP  DeviceInfoResponseDestination = "P"
+	DeviceInfoResponseDestinationS  DeviceInfoResponseDestination = "S"
+	DeviceInfoResponseDestinationH  DeviceInfoResponseDestination = "H"
+	DeviceInfoResponseDestinationUc DeviceInfoResponseDestination = "UC"
+)
+
+// Values returns every DeviceInfoResponseDestination the spec allows.
+func (DeviceInfoResponseDestination) Values() []DeviceInfoResponseDestination {
+	return []DeviceInfoResponseDestination{DeviceInfoResponseDestinationJ, DeviceInfoResponseDestinationU, DeviceInfoResponseDestinationC, DeviceInfoResponseDestinationR, DeviceInfoResponseDestinationB, DeviceInfoResponseDestinationG, DeviceInfoResponseDestinationBg, DeviceInfoResponseDestinationT, DeviceInfoResponseDestinationA, DeviceInfoResponseDestinationL, DeviceInfoResponseDestinationK, DeviceInfoResponseDestinationE, DeviceInfoResponseDestinationV, DeviceInfoResponseDestinationF, DeviceInfoResponseDestinationP, DeviceInfoResponseDestinationS, DeviceInfoResponseDestinationH, DeviceInfoResponseDestinationUc}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v DeviceInfoResponseDestination) Valid() bool {
+	switch v {
+	case DeviceInfoResponseDestinationJ, DeviceInfoResponseDestinationU, DeviceInfoResponseDestinationC, DeviceInfoResponseDestinationR, DeviceInfoResponseDestinationB, DeviceInfoResponseDestinationG, DeviceInfoResponseDestinationBg, DeviceInfoResponseDestinationT, DeviceInfoResponseDestinationA, DeviceInfoResponseDestinationL, DeviceInfoResponseDestinationK, DeviceInfoResponseDestinationE, DeviceInfoResponseDestinationV, DeviceInfoResponseDestinationF, DeviceInfoResponseDestinationP, DeviceInfoResponseDestinationS, DeviceInfoResponseDestinationH, DeviceInfoResponseDestinationUc:
+		return true
+	}
+	return false
+}
+
+// DeviceInfoResponse is the DeviceInfoResponse schema of the spec.
+type DeviceInfoResponse struct {
+	APIVersion *float64 `json:"api_version,omitempty"`
+	// Category codes:
+	CategoryCode *int                           `json:"category_code,omitempty"`
+	Destination  *DeviceInfoResponseDestination `json:"destination,omitempty"`
+	// 12-digit ASCII device ID
+	DeviceID            *string       `json:"device_id,omitempty"`
+	ModelName           *string       `json:"model_name,omitempty"`
+	NetmoduleChecksum   *string       `json:"netmodule_checksum,omitempty"`
+	NetmoduleGeneration *int          `json:"netmodule_generation,omitempty"`
+	NetmoduleVersion    *string       `json:"netmodule_version,omitempty"`
+	ResponseCode        *ResponseCode `json:"response_code,omitempty"`
+	SerialNumber        *string       `json:"serial_number,omitempty"`
+	SystemVersion       *float64      `json:"system_version,omitempty"`
+}
+
+// DistributionInfoResponseBuildDisableItem is an element of the build_disable field of DistributionInfoResponse.
+type DistributionInfoResponseBuildDisableItem struct {
+	Reasons []string `json:"reasons,omitempty"`
+	Role    *string  `json:"role,omitempty"`
+}
+
+// DistributionInfoResponseClientListItemDataType is the data_type field of DistributionInfoResponseClientListItem.
+type DistributionInfoResponseClientListItemDataType string
+
+const (
+	DistributionInfoResponseClientListItemDataTypeBase DistributionInfoResponseClientListItemDataType = "base"
+	DistributionInfoResponseClientListItemDataTypeExt  DistributionInfoResponseClientListItemDataType = "ext"
+)
+
+// Values returns every DistributionInfoResponseClientListItemDataType the spec allows.
+func (DistributionInfoResponseClientListItemDataType) Values() []DistributionInfoResponseClientListItemDataType {
+	return []DistributionInfoResponseClientListItemDataType{DistributionInfoResponseClientListItemDataTypeBase, DistributionInfoResponseClientListItemDataTypeExt}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v DistributionInfoResponseClientListItemDataType) Valid() bool {
+	switch v {
+	case DistributionInfoResponseClientListItemDataTypeBase, DistributionInfoResponseClientListItemDataTypeExt:
+		return true
+	}
+	return false
+}
+
+// DistributionInfoResponseClientListItem is an element of the client_list field of DistributionInfoResponse.
+type DistributionInfoResponseClientListItem struct {
+	DataType  *DistributionInfoResponseClientListItemDataType `json:"data_type,omitempty"`
+	IPAddress *string                                         `json:"ip_address,omitempty"`
+}
+
+// DistributionInfoResponseRole is the role field of DistributionInfoResponse.
+type DistributionInfoResponseRole string
+
+const (
+	DistributionInfoResponseRoleServer DistributionInfoResponseRole = "server"
+	DistributionInfoResponseRoleClient DistributionInfoResponseRole = "client"
+	DistributionInfoResponseRoleNone   DistributionInfoResponseRole = "none"
+)
+
+// Values returns every DistributionInfoResponseRole the spec allows.
+func (DistributionInfoResponseRole) Values() []DistributionInfoResponseRole {
+	return []DistributionInfoResponseRole{DistributionInfoResponseRoleServer, DistributionInfoResponseRoleClient, DistributionInfoResponseRoleNone}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v DistributionInfoResponseRole) Valid() bool {
+	switch v {
+	case DistributionInfoResponseRoleServer, DistributionInfoResponseRoleClient, DistributionInfoResponseRoleNone:
+		return true
+	}
+	return false
+}
+
+// DistributionInfoResponseStatus is the status field of DistributionInfoResponse.
+type DistributionInfoResponseStatus string
+
+const (
+	DistributionInfoResponseStatusBuilding DistributionInfoResponseStatus = "building"
+	DistributionInfoResponseStatusWorking  DistributionInfoResponseStatus = "working"
+	DistributionInfoResponseStatusDeleting DistributionInfoResponseStatus = "deleting"
+)
+
+// Values returns every DistributionInfoResponseStatus the spec allows.
+func (DistributionInfoResponseStatus) Values() []DistributionInfoResponseStatus {
+	return []DistributionInfoResponseStatus{DistributionInfoResponseStatusBuilding, DistributionInfoResponseStatusWorking, DistributionInfoResponseStatusDeleting}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v DistributionInfoResponseStatus) Valid() bool {
+	switch v {
+	case DistributionInfoResponseStatusBuilding, DistributionInfoResponseStatusWorking, DistributionInfoResponseStatusDeleting:
+		return true
+	}
+	return false
+}
+
+// DistributionInfoResponse is the DistributionInfoResponse schema of the spec.
+type DistributionInfoResponse struct {
+	AudioDropout *bool                                      `json:"audio_dropout,omitempty"`
+	BuildDisable []DistributionInfoResponseBuildDisableItem `json:"build_disable,omitempty"`
+	ClientList   []DistributionInfoResponseClientListItem   `json:"client_list,omitempty"`
+	// Group ID (32-digit hex)
+	GroupID      *string                         `json:"group_id,omitempty"`
+	GroupName    *string                         `json:"group_name,omitempty"`
+	ResponseCode *ResponseCode                   `json:"response_code,omitempty"`
+	Role         *DistributionInfoResponseRole   `json:"role,omitempty"`
+	ServerZone   *string                         `json:"server_zone,omitempty"`
+	Status       *DistributionInfoResponseStatus `json:"status,omitempty"`
+}
+
+// FeaturesResponseClock is the clock field of FeaturesResponse.
+type FeaturesResponseClock struct {
+	FuncList []string `json:"func_list,omitempty"`
+}
+
+// FeaturesResponseDistribution is the distribution field of FeaturesResponse.
+type FeaturesResponseDistribution struct {
+	ClientMax        *int     `json:"client_max,omitempty"`
+	CompatibleClient []int    `json:"compatible_client,omitempty"`
+	ServerZoneList   []string `json:"server_zone_list,omitempty"`
+	Version          *float64 `json:"version,omitempty"`
+}
+
+// FeaturesResponseNetusbPreset is the preset field of FeaturesResponseNetusb.
+type FeaturesResponseNetusbPreset struct {
+	Num *int `json:"num,omitempty"`
+}
+
+// FeaturesResponseNetusbRecentInfo is the recent_info field of FeaturesResponseNetusb.
+type FeaturesResponseNetusbRecentInfo struct {
+	Num *int `json:"num,omitempty"`
+}
+
+// FeaturesResponseNetusb is the netusb field of FeaturesResponse.
+type FeaturesResponseNetusb struct {
+	FuncList   []string                          `json:"func_list,omitempty"`
+	Preset     *FeaturesResponseNetusbPreset     `json:"preset,omitempty"`
+	RecentInfo *FeaturesResponseNetusbRecentInfo `json:"recent_info,omitempty"`
+}
+
+// FeaturesResponseSystemInputListItemPlayInfoType is the play_info_type field of FeaturesResponseSystemInputListItem.
+type FeaturesResponseSystemInputListItemPlayInfoType string
+
+const (
+	FeaturesResponseSystemInputListItemPlayInfoTypeNone   FeaturesResponseSystemInputListItemPlayInfoType = "none"
+	FeaturesResponseSystemInputListItemPlayInfoTypeTuner  FeaturesResponseSystemInputListItemPlayInfoType = "tuner"
+	FeaturesResponseSystemInputListItemPlayInfoTypeNetusb FeaturesResponseSystemInputListItemPlayInfoType = "netusb"
+	FeaturesResponseSystemInputListItemPlayInfoTypeCD     FeaturesResponseSystemInputListItemPlayInfoType = "cd"
+)
+
+// Values returns every FeaturesResponseSystemInputListItemPlayInfoType the spec allows.
+func (FeaturesResponseSystemInputListItemPlayInfoType) Values() []FeaturesResponseSystemInputListItemPlayInfoType {
+	return []FeaturesResponseSystemInputListItemPlayInfoType{FeaturesResponseSystemInputListItemPlayInfoTypeNone, FeaturesResponseSystemInputListItemPlayInfoTypeTuner, FeaturesResponseSystemInputListItemPlayInfoTypeNetusb, FeaturesResponseSystemInputListItemPlayInfoTypeCD}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v FeaturesResponseSystemInputListItemPlayInfoType) Valid() bool {
+	switch v {
+	case FeaturesResponseSystemInputListItemPlayInfoTypeNone, FeaturesResponseSystemInputListItemPlayInfoTypeTuner, FeaturesResponseSystemInputListItemPlayInfoTypeNetusb, FeaturesResponseSystemInputListItemPlayInfoTypeCD:
+		return true
+	}
+	return false
+}
+
+// FeaturesResponseSystemInputListItem is an element of the input_list field of FeaturesResponseSystem.
+type FeaturesResponseSystemInputListItem struct {
+	AccountEnable      *bool                                            `json:"account_enable,omitempty"`
+	DistributionEnable *bool                                            `json:"distribution_enable,omitempty"`
+	ID                 *string                                          `json:"id,omitempty"`
+	PlayInfoType       *FeaturesResponseSystemInputListItemPlayInfoType `json:"play_info_type,omitempty"`
+	RenameEnable       *bool                                            `json:"rename_enable,omitempty"`
+}
+
+// FeaturesResponseSystem is the system field of FeaturesResponse.
+type FeaturesResponseSystem struct {
+	FuncList  []string                              `json:"func_list,omitempty"`
+	InputList []FeaturesResponseSystemInputListItem `json:"input_list,omitempty"`
+	ZoneNum   *int                                  `json:"zone_num,omitempty"`
+}
+
+// FeaturesResponseTunerPresetType is the type field of FeaturesResponseTunerPreset.
+type FeaturesResponseTunerPresetType string
+
+const (
+	FeaturesResponseTunerPresetTypeCommon   FeaturesResponseTunerPresetType = "common"
+	FeaturesResponseTunerPresetTypeSeparate FeaturesResponseTunerPresetType = "separate"
+)
+
+// Values returns every FeaturesResponseTunerPresetType the spec allows.
+func (FeaturesResponseTunerPresetType) Values() []FeaturesResponseTunerPresetType {
+	return []FeaturesResponseTunerPresetType{FeaturesResponseTunerPresetTypeCommon, FeaturesResponseTunerPresetTypeSeparate}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v FeaturesResponseTunerPresetType) Valid() bool {
+	switch v {
+	case FeaturesResponseTunerPresetTypeCommon, FeaturesResponseTunerPresetTypeSeparate:
+		return true
+	}
+	return false
+}
+
+// FeaturesResponseTunerPreset is the preset field of FeaturesResponseTuner.
+type FeaturesResponseTunerPreset struct {
+	Num  *int                             `json:"num,omitempty"`
+	Type *FeaturesResponseTunerPresetType `json:"type,omitempty"`
+}
+
+// FeaturesResponseTuner is the tuner field of FeaturesResponse.
+type FeaturesResponseTuner struct {
+	FuncList  []string                     `json:"func_list,omitempty"`
+	Preset    *FeaturesResponseTunerPreset `json:"preset,omitempty"`
+	RangeStep []map[string]any             `json:"range_step,omitempty"`
+}
+
+// FeaturesResponseZoneItemRangeStepItem is an element of the range_step field of FeaturesResponseZoneItem.
+type FeaturesResponseZoneItemRangeStepItem struct {
+	ID   *string `json:"id,omitempty"`
+	Max  *int    `json:"max,omitempty"`
+	Min  *int    `json:"min,omitempty"`
+	Step *int    `json:"step,omitempty"`
+}
+
+// FeaturesResponseZoneItem is an element of the zone field of FeaturesResponse.
+type FeaturesResponseZoneItem struct {
+	FuncList         []string                                `json:"func_list,omitempty"`
+	ID               *string                                 `json:"id,omitempty"`
+	InputList        []string                                `json:"input_list,omitempty"`
+	RangeStep        []FeaturesResponseZoneItemRangeStepItem `json:"range_step,omitempty"`
+	SoundProgramList []string                                `json:"sound_program_list,omitempty"`
+}
+
+// FeaturesResponse is the FeaturesResponse schema of the spec.
+type FeaturesResponse struct {
+	Clock        *FeaturesResponseClock        `json:"clock,omitempty"`
+	Distribution *FeaturesResponseDistribution `json:"distribution,omitempty"`
+	Netusb       *FeaturesResponseNetusb       `json:"netusb,omitempty"`
+	ResponseCode *ResponseCode                 `json:"response_code,omitempty"`
+	System       *FeaturesResponseSystem       `json:"system,omitempty"`
+	Tuner        *FeaturesResponseTuner        `json:"tuner,omitempty"`
+	Zone         []FeaturesResponseZoneItem    `json:"zone,omitempty"`
+}
+
+// FuncStatusResponse is the FuncStatusResponse schema of the spec.
+type FuncStatusResponse struct {
+	AutoPlay         *bool         `json:"auto_play,omitempty"`
+	AutoPowerStandby *bool         `json:"auto_power_standby,omitempty"`
+	Dimmer           *int          `json:"dimmer,omitempty"`
+	HDMIOut1         *bool         `json:"hdmi_out_1,omitempty"`
+	HDMIOut2         *bool         `json:"hdmi_out_2,omitempty"`
+	HDMIOut3         *bool         `json:"hdmi_out_3,omitempty"`
+	Headphone        *bool         `json:"headphone,omitempty"`
+	IRSensor         *bool         `json:"ir_sensor,omitempty"`
+	PartyMode        *bool         `json:"party_mode,omitempty"`
+	ResponseCode     *ResponseCode `json:"response_code,omitempty"`
+	SpeakerA         *bool         `json:"speaker_a,omitempty"`
+	SpeakerB         *bool         `json:"speaker_b,omitempty"`
+	SpeakerPattern   *int          `json:"speaker_pattern,omitempty"`
+	ZoneBVolumeSync  *bool         `json:"zone_b_volume_sync,omitempty"`
+}
+
+// InputID is input IDs the API documents. Each model supports a subset, listed in.
+type InputID string
+
+const (
+	InputIDCD        InputID = "cd"
+	InputIDTuner     InputID = "tuner"
+	InputIDMultiCh   InputID = "multi_ch"
+	InputIDPhono     InputID = "phono"
+	InputIDHdmi1     InputID = "hdmi1"
+	InputIDHdmi2     InputID = "hdmi2"
+	InputIDHdmi3     InputID = "hdmi3"
+	InputIDHdmi4     InputID = "hdmi4"
+	InputIDHdmi5     InputID = "hdmi5"
+	InputIDHdmi6     InputID = "hdmi6"
+	InputIDHdmi7     InputID = "hdmi7"
+	InputIDHdmi8     InputID = "hdmi8"
+	InputIDHDMI      InputID = "hdmi"
+	InputIDAv1       InputID = "av1"
+	InputIDAv2       InputID = "av2"
+	InputIDAv3       InputID = "av3"
+	InputIDAv4       InputID = "av4"
+	InputIDAv5       InputID = "av5"
+	InputIDAv6       InputID = "av6"
+	InputIDAv7       InputID = "av7"
+	InputIDVAux      InputID = "v_aux"
+	InputIDAux1      InputID = "aux1"
+	InputIDAux2      InputID = "aux2"
+	InputIDAux       InputID = "aux"
+	InputIDAudio1    InputID = "audio1"
+	InputIDAudio2    InputID = "audio2"
+	InputIDAudio3    InputID = "audio3"
+	InputIDAudio4    InputID = "audio4"
+	InputIDAudioCD   InputID = "audio_cd"
+	InputIDAudio     InputID = "audio"
+	InputIDOptical1  InputID = "optical1"
+	InputIDOptical2  InputID = "optical2"
+	InputIDOptical   InputID = "optical"
+	InputIDCoaxial1  InputID = "coaxial1"
+	InputIDCoaxial2  InputID = "coaxial2"
+	InputIDCoaxial   InputID = "coaxial"
+	InputIDDigital1  InputID = "digital1"
+	InputIDDigital2  InputID = "digital2"
+	InputIDDigital   InputID = "digital"
+	InputIDLine1     InputID = "line1"
+	InputIDLine2     InputID = "line2"
+	InputIDLine3     InputID = "line3"
+	InputIDLineCD    InputID = "line_cd"
+	InputIDAnalog    InputID = "analog"
+	InputIDTv        InputID = "tv"
+	InputIDBdDvd     InputID = "bd_dvd"
+	InputIDUSBDac    InputID = "usb_dac"
+	InputIDUSB       InputID = "usb"
+	InputIDBluetooth InputID = "bluetooth"
+	InputIDServer    InputID = "server"
+	InputIDNetRadio  InputID = "net_radio"
+	InputIDRhapsody  InputID = "rhapsody"
+	InputIDNapster   InputID = "napster"
+	InputIDPandora   InputID = "pandora"
+	InputIDSiriusxm  InputID = "siriusxm"
+	InputIDSpotify   InputID = "spotify"
+	InputIDJuke      InputID = "juke"
+	InputIDAirplay   InputID = "airplay"
+	InputIDRadiko    InputID = "radiko"
+	InputIDQobuz     InputID = "qobuz"
+	InputIDMcLink    InputID = "mc_link"
+	InputIDMainSync  InputID = "main_sync"
+	InputIDNone      InputID = "none"
+)
+
+// Values returns every InputID the spec allows.
+func (InputID) Values() []InputID {
+	return []InputID{InputIDCD, InputIDTuner, InputIDMultiCh, InputIDPhono, InputIDHdmi1, InputIDHdmi2, InputIDHdmi3, InputIDHdmi4, InputIDHdmi5, InputIDHdmi6, InputIDHdmi7, InputIDHdmi8, InputIDHDMI, InputIDAv1, InputIDAv2, InputIDAv3, InputIDAv4, InputIDAv5, InputIDAv6, InputIDAv7, InputIDVAux, InputIDAux1, InputIDAux2, InputIDAux, InputIDAudio1, InputIDAudio2, InputIDAudio3, InputIDAudio4, InputIDAudioCD, InputIDAudio, InputIDOptical1, InputIDOptical2, InputIDOptical, InputIDCoaxial1, InputIDCoaxial2, InputIDCoaxial, InputIDDigital1, InputIDDigital2, InputIDDigital, InputIDLine1, InputIDLine2, InputIDLine3, InputIDLineCD, InputIDAnalog, InputIDTv, InputIDBdDvd, InputIDUSBDac, InputIDUSB, InputIDBluetooth, InputIDServer, InputIDNetRadio, InputIDRhapsody, InputIDNapster, InputIDPandora, InputIDSiriusxm, InputIDSpotify, InputIDJuke, InputIDAirplay, InputIDRadiko, InputIDQobuz, InputIDMcLink, InputIDMainSync, InputIDNone}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v InputID) Valid() bool {
+	switch v {
+	case InputIDCD, InputIDTuner, InputIDMultiCh, InputIDPhono, InputIDHdmi1, InputIDHdmi2, InputIDHdmi3, InputIDHdmi4, InputIDHdmi5, InputIDHdmi6, InputIDHdmi7, InputIDHdmi8, InputIDHDMI, InputIDAv1, InputIDAv2, InputIDAv3, InputIDAv4, InputIDAv5, InputIDAv6, InputIDAv7, InputIDVAux, InputIDAux1, InputIDAux2, InputIDAux, InputIDAudio1, InputIDAudio2, InputIDAudio3, InputIDAudio4, InputIDAudioCD, InputIDAudio, InputIDOptical1, InputIDOptical2, InputIDOptical, InputIDCoaxial1, InputIDCoaxial2, InputIDCoaxial, InputIDDigital1, InputIDDigital2, InputIDDigital, InputIDLine1, InputIDLine2, InputIDLine3, InputIDLineCD, InputIDAnalog, InputIDTv, InputIDBdDvd, InputIDUSBDac, InputIDUSB, InputIDBluetooth, InputIDServer, InputIDNetRadio, InputIDRhapsody, InputIDNapster, InputIDPandora, InputIDSiriusxm, InputIDSpotify, InputIDJuke, InputIDAirplay, InputIDRadiko, InputIDQobuz, InputIDMcLink, InputIDMainSync, InputIDNone:
+		return true
+	}
+	return false
+}
+
+// NetUSBListInfoResponseListInfoItem is an element of the list_info field of NetUSBListInfoResponse.
+type NetUSBListInfoResponseListInfoItem struct {
+	Attribute *int     `json:"attribute,omitempty"`
+	Subtexts  []string `json:"subtexts,omitempty"`
+	Text      *string  `json:"text,omitempty"`
+	Thumbnail *string  `json:"thumbnail,omitempty"`
+}
+
+// NetUSBListInfoResponse is the NetUsbListInfoResponse schema of the spec.
+type NetUSBListInfoResponse struct {
+	Index        *int                                 `json:"index,omitempty"`
+	Input        *string                              `json:"input,omitempty"`
+	ListInfo     []NetUSBListInfoResponseListInfoItem `json:"list_info,omitempty"`
+	MaxLine      *int                                 `json:"max_line,omitempty"`
+	MenuLayer    *int                                 `json:"menu_layer,omitempty"`
+	MenuName     *string                              `json:"menu_name,omitempty"`
+	PlayingIndex *int                                 `json:"playing_index,omitempty"`
+	ResponseCode *ResponseCode                        `json:"response_code,omitempty"`
+}
+
+// NetUSBPlayInfoResponsePlayback is the playback field of NetUSBPlayInfoResponse.
+type NetUSBPlayInfoResponsePlayback string
+
+const (
+	NetUSBPlayInfoResponsePlaybackPlay        NetUSBPlayInfoResponsePlayback = "play"
+	NetUSBPlayInfoResponsePlaybackStop        NetUSBPlayInfoResponsePlayback = "stop"
+	NetUSBPlayInfoResponsePlaybackPause       NetUSBPlayInfoResponsePlayback = "pause"
+	NetUSBPlayInfoResponsePlaybackFastReverse NetUSBPlayInfoResponsePlayback = "fast_reverse"
+	NetUSBPlayInfoResponsePlaybackFastForward NetUSBPlayInfoResponsePlayback = "fast_forward"
+)
+
+// Values returns every NetUSBPlayInfoResponsePlayback the spec allows.
+func (NetUSBPlayInfoResponsePlayback) Values() []NetUSBPlayInfoResponsePlayback {
+	return []NetUSBPlayInfoResponsePlayback{NetUSBPlayInfoResponsePlaybackPlay, NetUSBPlayInfoResponsePlaybackStop, NetUSBPlayInfoResponsePlaybackPause, NetUSBPlayInfoResponsePlaybackFastReverse, NetUSBPlayInfoResponsePlaybackFastForward}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v NetUSBPlayInfoResponsePlayback) Valid() bool {
+	switch v {
+	case NetUSBPlayInfoResponsePlaybackPlay, NetUSBPlayInfoResponsePlaybackStop, NetUSBPlayInfoResponsePlaybackPause, NetUSBPlayInfoResponsePlaybackFastReverse, NetUSBPlayInfoResponsePlaybackFastForward:
+		return true
+	}
+	return false
+}
+
+// NetUSBPlayInfoResponseRepeat is the repeat field of NetUSBPlayInfoResponse.
+type NetUSBPlayInfoResponseRepeat string
+
+const (
+	NetUSBPlayInfoResponseRepeatOff NetUSBPlayInfoResponseRepeat = "off"
+	NetUSBPlayInfoResponseRepeatOne NetUSBPlayInfoResponseRepeat = "one"
+	NetUSBPlayInfoResponseRepeatAll NetUSBPlayInfoResponseRepeat = "all"
+)
+
+// Values returns every NetUSBPlayInfoResponseRepeat the spec allows.
+func (NetUSBPlayInfoResponseRepeat) Values() []NetUSBPlayInfoResponseRepeat {
+	return []NetUSBPlayInfoResponseRepeat{NetUSBPlayInfoResponseRepeatOff, NetUSBPlayInfoResponseRepeatOne, NetUSBPlayInfoResponseRepeatAll}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v NetUSBPlayInfoResponseRepeat) Valid() bool {
+	switch v {
+	case NetUSBPlayInfoResponseRepeatOff, NetUSBPlayInfoResponseRepeatOne, NetUSBPlayInfoResponseRepeatAll:
+		return true
+	}
+	return false
+}
+
+// NetUSBPlayInfoResponseShuffle is the shuffle field of NetUSBPlayInfoResponse.
+type NetUSBPlayInfoResponseShuffle string
+
+const (
+	NetUSBPlayInfoResponseShuffleOff    NetUSBPlayInfoResponseShuffle = "off"
+	NetUSBPlayInfoResponseShuffleOn     NetUSBPlayInfoResponseShuffle = "on"
+	NetUSBPlayInfoResponseShuffleSongs  NetUSBPlayInfoResponseShuffle = "songs"
+	NetUSBPlayInfoResponseShuffleAlbums NetUSBPlayInfoResponseShuffle = "albums"
+)
+
+// Values returns every NetUSBPlayInfoResponseShuffle the spec allows.
+func (NetUSBPlayInfoResponseShuffle) Values() []NetUSBPlayInfoResponseShuffle {
+	return []NetUSBPlayInfoResponseShuffle{NetUSBPlayInfoResponseShuffleOff, NetUSBPlayInfoResponseShuffleOn, NetUSBPlayInfoResponseShuffleSongs, NetUSBPlayInfoResponseShuffleAlbums}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v NetUSBPlayInfoResponseShuffle) Valid() bool {
+	switch v {
+	case NetUSBPlayInfoResponseShuffleOff, NetUSBPlayInfoResponseShuffleOn, NetUSBPlayInfoResponseShuffleSongs, NetUSBPlayInfoResponseShuffleAlbums:
+		return true
+	}
+	return false
+}
+
+// NetUSBPlayInfoResponseUSBDevicetype is the usb_devicetype field of NetUSBPlayInfoResponse.
+type NetUSBPlayInfoResponseUSBDevicetype string
+
+const (
+	NetUSBPlayInfoResponseUSBDevicetypeMsc     NetUSBPlayInfoResponseUSBDevicetype = "msc"
+	NetUSBPlayInfoResponseUSBDevicetypeUnknown NetUSBPlayInfoResponseUSBDevicetype = "unknown"
+)
+
+// Values returns every NetUSBPlayInfoResponseUSBDevicetype the spec allows.
+func (NetUSBPlayInfoResponseUSBDevicetype) Values() []NetUSBPlayInfoResponseUSBDevicetype {
+	return []NetUSBPlayInfoResponseUSBDevicetype{NetUSBPlayInfoResponseUSBDevicetypeMsc, NetUSBPlayInfoResponseUSBDevicetypeUnknown}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v NetUSBPlayInfoResponseUSBDevicetype) Valid() bool {
+	switch v {
+	case NetUSBPlayInfoResponseUSBDevicetypeMsc, NetUSBPlayInfoResponseUSBDevicetypeUnknown:
+		return true
+	}
+	return false
+}
+
+// NetUSBPlayInfoResponse is the NetUsbPlayInfoResponse schema of the spec.
+type NetUSBPlayInfoResponse struct {
+	Album       *string `json:"album,omitempty"`
+	AlbumartID  *int    `json:"albumart_id,omitempty"`
+	AlbumartURL *string `json:"albumart_url,omitempty"`
+	Artist      *string `json:"artist,omitempty"`
+	Attribute   *int    `json:"attribute,omitempty"`
+	Input       *string `json:"input,omitempty"`
+	// Current playback time in seconds (-60000 if invalid)
+	PlayTime         *int                            `json:"play_time,omitempty"`
+	Playback         *NetUSBPlayInfoResponsePlayback `json:"playback,omitempty"`
+	Repeat           *NetUSBPlayInfoResponseRepeat   `json:"repeat,omitempty"`
+	RepeatAvailable  []string                        `json:"repeat_available,omitempty"`
+	ResponseCode     *ResponseCode                   `json:"response_code,omitempty"`
+	Shuffle          *NetUSBPlayInfoResponseShuffle  `json:"shuffle,omitempty"`
+	ShuffleAvailable []string                        `json:"shuffle_available,omitempty"`
+	// Total time in seconds
+	TotalTime     *int                                 `json:"total_time,omitempty"`
+	Track         *string                              `json:"track,omitempty"`
+	USBDevicetype *NetUSBPlayInfoResponseUSBDevicetype `json:"usb_devicetype,omitempty"`
+}
+
+// NetUSBPresetInfoResponsePresetInfoItem is an element of the preset_info field of NetUSBPresetInfoResponse.
+type NetUSBPresetInfoResponsePresetInfoItem struct {
+	Attribute *int    `json:"attribute,omitempty"`
+	Input     *string `json:"input,omitempty"`
+	Text      *string `json:"text,omitempty"`
+}
+
+// NetUSBPresetInfoResponse is the NetUsbPresetInfoResponse schema of the spec.
+type NetUSBPresetInfoResponse struct {
+	FuncList     []string                                 `json:"func_list,omitempty"`
+	PresetInfo   []NetUSBPresetInfoResponsePresetInfoItem `json:"preset_info,omitempty"`
+	ResponseCode *ResponseCode                            `json:"response_code,omitempty"`
+}
+
+// NetworkStatusResponseConnection is the connection field of NetworkStatusResponse.
+type NetworkStatusResponseConnection string
+
+const (
+	NetworkStatusResponseConnectionWiredLAN       NetworkStatusResponseConnection = "wired_lan"
+	NetworkStatusResponseConnectionWirelessLAN    NetworkStatusResponseConnection = "wireless_lan"
+	NetworkStatusResponseConnectionWirelessDirect NetworkStatusResponseConnection = "wireless_direct"
+	NetworkStatusResponseConnectionExtend1        NetworkStatusResponseConnection = "extend_1"
+	NetworkStatusResponseConnectionExtend2        NetworkStatusResponseConnection = "extend_2"
+	NetworkStatusResponseConnectionExtend3        NetworkStatusResponseConnection = "extend_3"
+	NetworkStatusResponseConnectionUnknown        NetworkStatusResponseConnection = "unknown"
+)
+
+// Values returns every NetworkStatusResponseConnection the spec allows.
+func (NetworkStatusResponseConnection) Values() []NetworkStatusResponseConnection {
+	return []NetworkStatusResponseConnection{NetworkStatusResponseConnectionWiredLAN, NetworkStatusResponseConnectionWirelessLAN, NetworkStatusResponseConnectionWirelessDirect, NetworkStatusResponseConnectionExtend1, NetworkStatusResponseConnectionExtend2, NetworkStatusResponseConnectionExtend3, NetworkStatusResponseConnectionUnknown}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v NetworkStatusResponseConnection) Valid() bool {
+	switch v {
+	case NetworkStatusResponseConnectionWiredLAN, NetworkStatusResponseConnectionWirelessLAN, NetworkStatusResponseConnectionWirelessDirect, NetworkStatusResponseConnectionExtend1, NetworkStatusResponseConnectionExtend2, NetworkStatusResponseConnectionExtend3, NetworkStatusResponseConnectionUnknown:
+		return true
+	}
+	return false
+}
+
+// NetworkStatusResponseMACAddress is the mac_address field of NetworkStatusResponse.
+type NetworkStatusResponseMACAddress struct {
+	WiredLAN       *string `json:"wired_lan,omitempty"`
+	WirelessDirect *string `json:"wireless_direct,omitempty"`
+	WirelessLAN    *string `json:"wireless_lan,omitempty"`
+}
+
+// NetworkStatusResponseWirelessLAN is the wireless_lan field of NetworkStatusResponse.
+type NetworkStatusResponseWirelessLAN struct {
+	Ch          *int    `json:"ch,omitempty"`
+	Key         *string `json:"key,omitempty"`
+	SignalLevel *int    `json:"signal_level,omitempty"`
+	SSID        *string `json:"ssid,omitempty"`
+	Type        *string `json:"type,omitempty"`
+}
+
+// NetworkStatusResponse is the NetworkStatusResponse schema of the spec.
+type NetworkStatusResponse struct {
+	Connection     *NetworkStatusResponseConnection  `json:"connection,omitempty"`
+	DefaultGateway *string                           `json:"default_gateway,omitempty"`
+	DHCP           *bool                             `json:"dhcp,omitempty"`
+	DNSServer1     *string                           `json:"dns_server_1,omitempty"`
+	DNSServer2     *string                           `json:"dns_server_2,omitempty"`
+	IPAddress      *string                           `json:"ip_address,omitempty"`
+	MACAddress     *NetworkStatusResponseMACAddress  `json:"mac_address,omitempty"`
+	NetworkName    *string                           `json:"network_name,omitempty"`
+	ResponseCode   *ResponseCode                     `json:"response_code,omitempty"`
+	SubnetMask     *string                           `json:"subnet_mask,omitempty"`
+	WirelessLAN    *NetworkStatusResponseWirelessLAN `json:"wireless_lan,omitempty"`
+}
+
+// ServerInfoRequestType is the type field of ServerInfoRequest.
+type ServerInfoRequestType string
+
+const (
+	ServerInfoRequestTypeAdd    ServerInfoRequestType = "add"
+	ServerInfoRequestTypeRemove ServerInfoRequestType = "remove"
+)
+
+// Values returns every ServerInfoRequestType the spec allows.
+func (ServerInfoRequestType) Values() []ServerInfoRequestType {
+	return []ServerInfoRequestType{ServerInfoRequestTypeAdd, ServerInfoRequestTypeRemove}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ServerInfoRequestType) Valid() bool {
+	switch v {
+	case ServerInfoRequestTypeAdd, ServerInfoRequestTypeRemove:
+		return true
+	}
+	return false
+}
+
+// ServerInfoRequestZone is the zone field of ServerInfoRequest.
+type ServerInfoRequestZone string
+
+const (
+	ServerInfoRequestZoneMain  ServerInfoRequestZone = "main"
+	ServerInfoRequestZoneZone2 ServerInfoRequestZone = "zone2"
+	ServerInfoRequestZoneZone3 ServerInfoRequestZone = "zone3"
+	ServerInfoRequestZoneZone4 ServerInfoRequestZone = "zone4"
+)
+
+// Values returns every ServerInfoRequestZone the spec allows.
+func (ServerInfoRequestZone) Values() []ServerInfoRequestZone {
+	return []ServerInfoRequestZone{ServerInfoRequestZoneMain, ServerInfoRequestZoneZone2, ServerInfoRequestZoneZone3, ServerInfoRequestZoneZone4}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ServerInfoRequestZone) Valid() bool {
+	switch v {
+	case ServerInfoRequestZoneMain, ServerInfoRequestZoneZone2, ServerInfoRequestZoneZone3, ServerInfoRequestZoneZone4:
+		return true
+	}
+	return false
+}
+
+// ServerInfoRequest is the ServerInfoRequest schema of the spec.
+type ServerInfoRequest struct {
+	// Client IP addresses
+	ClientList []string `json:"client_list,omitempty"`
+	// Group ID (32-digit hex, empty to cancel)
+	GroupID string                 `json:"group_id"`
+	Type    *ServerInfoRequestType `json:"type,omitempty"`
+	Zone    *ServerInfoRequestZone `json:"zone,omitempty"`
+}
+
+// SoundProgramID is sound program IDs the API documents. Each model supports a subset,.
+type SoundProgramID string
+
+const (
+	SoundProgramIDMunichA         SoundProgramID = "munich_a"
+	SoundProgramIDMunichB         SoundProgramID = "munich_b"
+	SoundProgramIDMunich          SoundProgramID = "munich"
+	SoundProgramIDFrankfurt       SoundProgramID = "frankfurt"
+	SoundProgramIDStuttgart       SoundProgramID = "stuttgart"
+	SoundProgramIDVienna          SoundProgramID = "vienna"
+	SoundProgramIDAmsterdam       SoundProgramID = "amsterdam"
+	SoundProgramIDUsaA            SoundProgramID = "usa_a"
+	SoundProgramIDUsaB            SoundProgramID = "usa_b"
+	SoundProgramIDTokyo           SoundProgramID = "tokyo"
+	SoundProgramIDFreiburg        SoundProgramID = "freiburg"
+	SoundProgramIDRoyaumont       SoundProgramID = "royaumont"
+	SoundProgramIDChamber         SoundProgramID = "chamber"
+	SoundProgramIDConcert         SoundProgramID = "concert"
+	SoundProgramIDVillageGate     SoundProgramID = "village_gate"
+	SoundProgramIDVillageVanguard SoundProgramID = "village_vanguard"
+	SoundProgramIDWarehouseLoft   SoundProgramID = "warehouse_loft"
+	SoundProgramIDCellarClub      SoundProgramID = "cellar_club"
+	SoundProgramIDJazzClub        SoundProgramID = "jazz_club"
+	SoundProgramIDRoxyTheatre     SoundProgramID = "roxy_theatre"
+	SoundProgramIDBottomLine      SoundProgramID = "bottom_line"
+	SoundProgramIDArena           SoundProgramID = "arena"
+	SoundProgramIDSports          SoundProgramID = "sports"
+	SoundProgramIDActionGame      SoundProgramID = "action_game"
+	SoundProgramIDRoleplayingGame SoundProgramID = "roleplaying_game"
+	SoundProgramIDGame            SoundProgramID = "game"
+	SoundProgramIDMusicVideo      SoundProgramID = "music_video"
+	SoundProgramIDMusic           SoundProgramID = "music"
+	SoundProgramIDRecitalOpera    SoundProgramID = "recital_opera"
+	SoundProgramIDPavilion        SoundProgramID = "pavilion"
+	SoundProgramIDDisco           SoundProgramID = "disco"
+	SoundProgramIDStandard        SoundProgramID = "standard"
+	SoundProgramIDSpectacle       SoundProgramID = "spectacle"
+	SoundProgramIDSciFi           SoundProgramID = "sci-fi"
+	SoundProgramIDAdventure       SoundProgramID = "adventure"
+	SoundProgramIDDrama           SoundProgramID = "drama"
+	SoundProgramIDTalkShow        SoundProgramID = "talk_show"
+	SoundProgramIDTvProgram       SoundProgramID = "tv_program"
+	SoundProgramIDMonoMovie       SoundProgramID = "mono_movie"
+	SoundProgramIDMovie           SoundProgramID = "movie"
+	SoundProgramIDEnhanced        SoundProgramID = "enhanced"
+	SoundProgramID2chStereo       SoundProgramID = "2ch_stereo"
+	SoundProgramID5chStereo       SoundProgramID = "5ch_stereo"
+	SoundProgramID7chStereo       SoundProgramID = "7ch_stereo"
+	SoundProgramID9chStereo       SoundProgramID = "9ch_stereo"
+	SoundProgramID11chStereo      SoundProgramID = "11ch_stereo"
+	SoundProgramIDStereo          SoundProgramID = "stereo"
+	SoundProgramIDSurrDecoder     SoundProgramID = "surr_decoder"
+	SoundProgramIDMySurround      SoundProgramID = "my_surround"
+	SoundProgramIDTarget          SoundProgramID = "target"
+	SoundProgramIDStraight        SoundProgramID = "straight"
+	SoundProgramIDOff             SoundProgramID = "off"
+)
+
+// Values returns every SoundProgramID the spec allows.
+func (SoundProgramID) Values() []SoundProgramID {
+	return []SoundProgramID{SoundProgramIDMunichA, SoundProgramIDMunichB, SoundProgramIDMunich, SoundProgramIDFrankfurt, SoundProgramIDStuttgart, SoundProgramIDVienna, SoundProgramIDAmsterdam, SoundProgramIDUsaA, SoundProgramIDUsaB, SoundProgramIDTokyo, SoundProgramIDFreiburg, SoundProgramIDRoyaumont, SoundProgramIDChamber, SoundProgramIDConcert, SoundProgramIDVillageGate, SoundProgramIDVillageVanguard, SoundProgramIDWarehouseLoft, SoundProgramIDCellarClub, SoundProgramIDJazzClub, SoundProgramIDRoxyTheatre, SoundProgramIDBottomLine, SoundProgramIDArena, SoundProgramIDSports, SoundProgramIDActionGame, SoundProgramIDRoleplayingGame, SoundProgramIDGame, SoundProgramIDMusicVideo, SoundProgramIDMusic, SoundProgramIDRecitalOpera, SoundProgramIDPavilion, SoundProgramIDDisco, SoundProgramIDStandard, SoundProgramIDSpectacle, SoundProgramIDSciFi, SoundProgramIDAdventure, SoundProgramIDDrama, SoundProgramIDTalkShow, SoundProgramIDTvProgram, SoundProgramIDMonoMovie, SoundProgramIDMovie, SoundProgramIDEnhanced, SoundProgramID2chStereo, SoundProgramID5chStereo, SoundProgramID7chStereo, SoundProgramID9chStereo, SoundProgramID11chStereo, SoundProgramIDStereo, SoundProgramIDSurrDecoder, SoundProgramIDMySurround, SoundProgramIDTarget, SoundProgramIDStraight, SoundProgramIDOff}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SoundProgramID) Valid() bool {
+	switch v {
+	case SoundProgramIDMunichA, SoundProgramIDMunichB, SoundProgramIDMunich, SoundProgramIDFrankfurt, SoundProgramIDStuttgart, SoundProgramIDVienna, SoundProgramIDAmsterdam, SoundProgramIDUsaA, SoundProgramIDUsaB, SoundProgramIDTokyo, SoundProgramIDFreiburg, SoundProgramIDRoyaumont, SoundProgramIDChamber, SoundProgramIDConcert, SoundProgramIDVillageGate, SoundProgramIDVillageVanguard, SoundProgramIDWarehouseLoft, SoundProgramIDCellarClub, SoundProgramIDJazzClub, SoundProgramIDRoxyTheatre, SoundProgramIDBottomLine, SoundProgramIDArena, SoundProgramIDSports, SoundProgramIDActionGame, SoundProgramIDRoleplayingGame, SoundProgramIDGame, SoundProgramIDMusicVideo, SoundProgramIDMusic, SoundProgramIDRecitalOpera, SoundProgramIDPavilion, SoundProgramIDDisco, SoundProgramIDStandard, SoundProgramIDSpectacle, SoundProgramIDSciFi, SoundProgramIDAdventure, SoundProgramIDDrama, SoundProgramIDTalkShow, SoundProgramIDTvProgram, SoundProgramIDMonoMovie, SoundProgramIDMovie, SoundProgramIDEnhanced, SoundProgramID2chStereo, SoundProgramID5chStereo, SoundProgramID7chStereo, SoundProgramID9chStereo, SoundProgramID11chStereo, SoundProgramIDStereo, SoundProgramIDSurrDecoder, SoundProgramIDMySurround, SoundProgramIDTarget, SoundProgramIDStraight, SoundProgramIDOff:
+		return true
+	}
+	return false
+}
+
+// TunerPlayInfoResponseAM is the am field of TunerPlayInfoResponse.
+type TunerPlayInfoResponseAM struct {
+	Freq   *int  `json:"freq,omitempty"`
+	Preset *int  `json:"preset,omitempty"`
+	Tuned  *bool `json:"tuned,omitempty"`
+}
+
+// TunerPlayInfoResponseBand is the band field of TunerPlayInfoResponse.
+type TunerPlayInfoResponseBand string
+
+const (
+	TunerPlayInfoResponseBandAM  TunerPlayInfoResponseBand = "am"
+	TunerPlayInfoResponseBandFM  TunerPlayInfoResponseBand = "fm"
+	TunerPlayInfoResponseBandDAB TunerPlayInfoResponseBand = "dab"
+)
+
+// Values returns every TunerPlayInfoResponseBand the spec allows.
+func (TunerPlayInfoResponseBand) Values() []TunerPlayInfoResponseBand {
+	return []TunerPlayInfoResponseBand{TunerPlayInfoResponseBandAM, TunerPlayInfoResponseBandFM, TunerPlayInfoResponseBandDAB}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v TunerPlayInfoResponseBand) Valid() bool {
+	switch v {
+	case TunerPlayInfoResponseBandAM, TunerPlayInfoResponseBandFM, TunerPlayInfoResponseBandDAB:
+		return true
+	}
+	return false
+}
+
+// TunerPlayInfoResponseDAB is the dab field of TunerPlayInfoResponse.
+type TunerPlayInfoResponseDAB struct {
+	AudioMode     *string `json:"audio_mode,omitempty"`
+	BitRate       *int    `json:"bit_rate,omitempty"`
+	Category      *string `json:"category,omitempty"`
+	ChLabel       *string `json:"ch_label,omitempty"`
+	DABPlus       *bool   `json:"dab_plus,omitempty"`
+	Dls           *string `json:"dls,omitempty"`
+	EnsembleLabel *string `json:"ensemble_label,omitempty"`
+	Freq          *int    `json:"freq,omitempty"`
+	ID            *int    `json:"id,omitempty"`
+	OffAir        *bool   `json:"off_air,omitempty"`
+	Preset        *int    `json:"preset,omitempty"`
+	ProgramType   *string `json:"program_type,omitempty"`
+	Quality       *int    `json:"quality,omitempty"`
+	ServiceLabel  *string `json:"service_label,omitempty"`
+	Status        *string `json:"status,omitempty"`
+	TuneAid       *int    `json:"tune_aid,omitempty"`
+}
+
+// TunerPlayInfoResponseFMAudioMode is the audio_mode field of TunerPlayInfoResponseFM.
+type TunerPlayInfoResponseFMAudioMode string
+
+const (
+	TunerPlayInfoResponseFMAudioModeMono   TunerPlayInfoResponseFMAudioMode = "mono"
+	TunerPlayInfoResponseFMAudioModeStereo TunerPlayInfoResponseFMAudioMode = "stereo"
+)
+
+// Values returns every TunerPlayInfoResponseFMAudioMode the spec allows.
+func (TunerPlayInfoResponseFMAudioMode) Values() []TunerPlayInfoResponseFMAudioMode {
+	return []TunerPlayInfoResponseFMAudioMode{TunerPlayInfoResponseFMAudioModeMono, TunerPlayInfoResponseFMAudioModeStereo}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v TunerPlayInfoResponseFMAudioMode) Valid() bool {
+	switch v {
+	case TunerPlayInfoResponseFMAudioModeMono, TunerPlayInfoResponseFMAudioModeStereo:
+		return true
+	}
+	return false
+}
+
+// TunerPlayInfoResponseFM is the fm field of TunerPlayInfoResponse.
+type TunerPlayInfoResponseFM struct {
+	AudioMode *TunerPlayInfoResponseFMAudioMode `json:"audio_mode,omitempty"`
+	Freq      *int                              `json:"freq,omitempty"`
+	Preset    *int                              `json:"preset,omitempty"`
+	Tuned     *bool                             `json:"tuned,omitempty"`
+}
+
+// TunerPlayInfoResponseRds is the rds field of TunerPlayInfoResponse.
+type TunerPlayInfoResponseRds struct {
+	ProgramService *string `json:"program_service,omitempty"`
+	ProgramType    *string `json:"program_type,omitempty"`
+	RadioTextA     *string `json:"radio_text_a,omitempty"`
+	RadioTextB     *string `json:"radio_text_b,omitempty"`
+}
+
+// TunerPlayInfoResponse is the TunerPlayInfoResponse schema of the spec.
+type TunerPlayInfoResponse struct {
+	AM           *TunerPlayInfoResponseAM   `json:"am,omitempty"`
+	AutoPreset   *bool                      `json:"auto_preset,omitempty"`
+	AutoScan     *bool                      `json:"auto_scan,omitempty"`
+	Band         *TunerPlayInfoResponseBand `json:"band,omitempty"`
+	DAB          *TunerPlayInfoResponseDAB  `json:"dab,omitempty"`
+	FM           *TunerPlayInfoResponseFM   `json:"fm,omitempty"`
+	Rds          *TunerPlayInfoResponseRds  `json:"rds,omitempty"`
+	ResponseCode *ResponseCode              `json:"response_code,omitempty"`
+}
+
+// TunerPresetInfoResponsePresetInfoItemBand is the band field of TunerPresetInfoResponsePresetInfoItem.
+type TunerPresetInfoResponsePresetInfoItemBand string
+
+const (
+	TunerPresetInfoResponsePresetInfoItemBandAM      TunerPresetInfoResponsePresetInfoItemBand = "am"
+	TunerPresetInfoResponsePresetInfoItemBandFM      TunerPresetInfoResponsePresetInfoItemBand = "fm"
+	TunerPresetInfoResponsePresetInfoItemBandDAB     TunerPresetInfoResponsePresetInfoItemBand = "dab"
+	TunerPresetInfoResponsePresetInfoItemBandUnknown TunerPresetInfoResponsePresetInfoItemBand = "unknown"
+)
+
+// Values returns every TunerPresetInfoResponsePresetInfoItemBand the spec allows.
+func (TunerPresetInfoResponsePresetInfoItemBand) Values() []TunerPresetInfoResponsePresetInfoItemBand {
+	return []TunerPresetInfoResponsePresetInfoItemBand{TunerPresetInfoResponsePresetInfoItemBandAM, TunerPresetInfoResponsePresetInfoItemBandFM, TunerPresetInfoResponsePresetInfoItemBandDAB, TunerPresetInfoResponsePresetInfoItemBandUnknown}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v TunerPresetInfoResponsePresetInfoItemBand) Valid() bool {
+	switch v {
+	case TunerPresetInfoResponsePresetInfoItemBandAM, TunerPresetInfoResponsePresetInfoItemBandFM, TunerPresetInfoResponsePresetInfoItemBandDAB, TunerPresetInfoResponsePresetInfoItemBandUnknown:
+		return true
+	}
+	return false
+}
+
+// TunerPresetInfoResponsePresetInfoItem is an element of the preset_info field of TunerPresetInfoResponse.
+type TunerPresetInfoResponsePresetInfoItem struct {
+	Band      *TunerPresetInfoResponsePresetInfoItemBand `json:"band,omitempty"`
+	HdProgram *int                                       `json:"hd_program,omitempty"`
+	Number    *int                                       `json:"number,omitempty"`
+	Text      *string                                    `json:"text,omitempty"`
+}
+
+// TunerPresetInfoResponse is the TunerPresetInfoResponse schema of the spec.
+type TunerPresetInfoResponse struct {
+	FuncList     []string                                `json:"func_list,omitempty"`
+	PresetInfo   []TunerPresetInfoResponsePresetInfoItem `json:"preset_info,omitempty"`
+	ResponseCode *ResponseCode                           `json:"response_code,omitempty"`
+}
+
+// WiredLANSettings is the WiredLanSettings schema of the spec.
+type WiredLANSettings struct {
+	DefaultGateway *string `json:"default_gateway,omitempty"`
+	// Obtain the addresses by DHCP
+	DHCP       *bool   `json:"dhcp,omitempty"`
+	DNSServer1 *string `json:"dns_server_1,omitempty"`
+	DNSServer2 *string `json:"dns_server_2,omitempty"`
+	IPAddress  *string `json:"ip_address,omitempty"`
+	SubnetMask *string `json:"subnet_mask,omitempty"`
+}
+
+// WirelessLANSettingsType is security type.
+type WirelessLANSettingsType string
+
+const (
+	WirelessLANSettingsTypeNone       WirelessLANSettingsType = "none"
+	WirelessLANSettingsTypeWep        WirelessLANSettingsType = "wep"
+	WirelessLANSettingsTypeWpa2PskAes WirelessLANSettingsType = "wpa2-psk(aes)"
+	WirelessLANSettingsTypeMixedMode  WirelessLANSettingsType = "mixed_mode"
+)
+
+// Values returns every WirelessLANSettingsType the spec allows.
+func (WirelessLANSettingsType) Values() []WirelessLANSettingsType {
+	return []WirelessLANSettingsType{WirelessLANSettingsTypeNone, WirelessLANSettingsTypeWep, WirelessLANSettingsTypeWpa2PskAes, WirelessLANSettingsTypeMixedMode}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v WirelessLANSettingsType) Valid() bool {
+	switch v {
+	case WirelessLANSettingsTypeNone, WirelessLANSettingsTypeWep, WirelessLANSettingsTypeWpa2PskAes, WirelessLANSettingsTypeMixedMode:
+		return true
+	}
+	return false
+}
+
+// WirelessLANSettings is the WirelessLanSettings schema of the spec.
+type WirelessLANSettings struct {
+	DefaultGateway *string `json:"default_gateway,omitempty"`
+	// Obtain the addresses by DHCP
+	DHCP       *bool   `json:"dhcp,omitempty"`
+	DNSServer1 *string `json:"dns_server_1,omitempty"`
+	DNSServer2 *string `json:"dns_server_2,omitempty"`
+	IPAddress  *string `json:"ip_address,omitempty"`
+	// Security key (max 64 chars)
+	Key *string `json:"key,omitempty"`
+	// Network name (max 32 chars)
+	SSID       *string `json:"ssid,omitempty"`
+	SubnetMask *string `json:"subnet_mask,omitempty"`
+	// Security type
+	Type *WirelessLANSettingsType `json:"type,omitempty"`
+}
+
+// ZoneID is zone identifier.
+type ZoneID string
+
+const (
+	ZoneIDMain  ZoneID = "main"
+	ZoneIDZone2 ZoneID = "zone2"
+	ZoneIDZone3 ZoneID = "zone3"
+	ZoneIDZone4 ZoneID = "zone4"
+)
+
+// Values returns every ZoneID the spec allows.
+func (ZoneID) Values() []ZoneID {
+	return []ZoneID{ZoneIDMain, ZoneIDZone2, ZoneIDZone3, ZoneIDZone4}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ZoneID) Valid() bool {
+	switch v {
+	case ZoneIDMain, ZoneIDZone2, ZoneIDZone3, ZoneIDZone4:
+		return true
+	}
+	return false
+}
+
+// ZoneStatusResponseEqualizer is the equalizer field of ZoneStatusResponse.
+type ZoneStatusResponseEqualizer struct {
+	High *int    `json:"high,omitempty"`
+	Low  *int    `json:"low,omitempty"`
+	Mid  *int    `json:"mid,omitempty"`
+	Mode *string `json:"mode,omitempty"`
+}
+
+// ZoneStatusResponsePower is the power field of ZoneStatusResponse.
+type ZoneStatusResponsePower string
+
+const (
+	ZoneStatusResponsePowerOn      ZoneStatusResponsePower = "on"
+	ZoneStatusResponsePowerStandby ZoneStatusResponsePower = "standby"
+)
+
+// Values returns every ZoneStatusResponsePower the spec allows.
+func (ZoneStatusResponsePower) Values() []ZoneStatusResponsePower {
+	return []ZoneStatusResponsePower{ZoneStatusResponsePowerOn, ZoneStatusResponsePowerStandby}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ZoneStatusResponsePower) Valid() bool {
+	switch v {
+	case ZoneStatusResponsePowerOn, ZoneStatusResponsePowerStandby:
+		return true
+	}
+	return false
+}
+
+// ZoneStatusResponseSleep is the sleep field of ZoneStatusResponse.
+type ZoneStatusResponseSleep int
+
+const (
+	ZoneStatusResponseSleep0   ZoneStatusResponseSleep = 0
+	ZoneStatusResponseSleep30  ZoneStatusResponseSleep = 30
+	ZoneStatusResponseSleep60  ZoneStatusResponseSleep = 60
+	ZoneStatusResponseSleep90  ZoneStatusResponseSleep = 90
+	ZoneStatusResponseSleep120 ZoneStatusResponseSleep = 120
+)
+
+// Values returns every ZoneStatusResponseSleep the spec allows.
+func (ZoneStatusResponseSleep) Values() []ZoneStatusResponseSleep {
+	return []ZoneStatusResponseSleep{ZoneStatusResponseSleep0, ZoneStatusResponseSleep30, ZoneStatusResponseSleep60, ZoneStatusResponseSleep90, ZoneStatusResponseSleep120}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ZoneStatusResponseSleep) Valid() bool {
+	switch v {
+	case ZoneStatusResponseSleep0, ZoneStatusResponseSleep30, ZoneStatusResponseSleep60, ZoneStatusResponseSleep90, ZoneStatusResponseSleep120:
+		return true
+	}
+	return false
+}
+
+// ZoneStatusResponseToneControl is the tone_control field of ZoneStatusResponse.
+type ZoneStatusResponseToneControl struct {
+	Bass   *int    `json:"bass,omitempty"`
+	Mode   *string `json:"mode,omitempty"`
+	Treble *int    `json:"treble,omitempty"`
+}
+
+// ZoneStatusResponse is the ZoneStatusResponse schema of the spec.
+type ZoneStatusResponse struct {
+	Balance            *int                           `json:"balance,omitempty"`
+	BassExtension      *bool                          `json:"bass_extension,omitempty"`
+	ClearVoice         *bool                          `json:"clear_voice,omitempty"`
+	DialogueLevel      *int                           `json:"dialogue_level,omitempty"`
+	DialogueLift       *int                           `json:"dialogue_lift,omitempty"`
+	Direct             *bool                          `json:"direct,omitempty"`
+	DisableFlags       *int                           `json:"disable_flags,omitempty"`
+	DistributionEnable *bool                          `json:"distribution_enable,omitempty"`
+	Enhancer           *bool                          `json:"enhancer,omitempty"`
+	Equalizer          *ZoneStatusResponseEqualizer   `json:"equalizer,omitempty"`
+	Input              *string                        `json:"input,omitempty"`
+	InputText          *string                        `json:"input_text,omitempty"`
+	LinkAudioDelay     *string                        `json:"link_audio_delay,omitempty"`
+	LinkAudioQuality   *string                        `json:"link_audio_quality,omitempty"`
+	LinkControl        *string                        `json:"link_control,omitempty"`
+	MaxVolume          *int                           `json:"max_volume,omitempty"`
+	Mute               *bool                          `json:"mute,omitempty"`
+	Power              *ZoneStatusResponsePower       `json:"power,omitempty"`
+	PureDirect         *bool                          `json:"pure_direct,omitempty"`
+	ResponseCode       *ResponseCode                  `json:"response_code,omitempty"`
+	Sleep              *ZoneStatusResponseSleep       `json:"sleep,omitempty"`
+	SoundProgram       *string                        `json:"sound_program,omitempty"`
+	SubwooferVolume    *int                           `json:"subwoofer_volume,omitempty"`
+	SurrDecoderType    *string                        `json:"surr_decoder_type,omitempty"`
+	Surround3d         *bool                          `json:"surround_3d,omitempty"`
+	ToneControl        *ZoneStatusResponseToneControl `json:"tone_control,omitempty"`
+	Volume             *int                           `json:"volume,omitempty"`
+}
+
+// ClearTunerPresetBand is the band parameter of clearTunerPreset.
+type ClearTunerPresetBand string
+
+const (
+	ClearTunerPresetBandCommon ClearTunerPresetBand = "common"
+	ClearTunerPresetBandAM     ClearTunerPresetBand = "am"
+	ClearTunerPresetBandFM     ClearTunerPresetBand = "fm"
+	ClearTunerPresetBandDAB    ClearTunerPresetBand = "dab"
+)
+
+// Values returns every ClearTunerPresetBand the spec allows.
+func (ClearTunerPresetBand) Values() []ClearTunerPresetBand {
+	return []ClearTunerPresetBand{ClearTunerPresetBandCommon, ClearTunerPresetBandAM, ClearTunerPresetBandFM, ClearTunerPresetBandDAB}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ClearTunerPresetBand) Valid() bool {
+	switch v {
+	case ClearTunerPresetBandCommon, ClearTunerPresetBandAM, ClearTunerPresetBandFM, ClearTunerPresetBandDAB:
+		return true
+	}
+	return false
+}
+
+// ControlCursorCursor is the cursor parameter of controlCursor.
+type ControlCursorCursor string
+
+const (
+	ControlCursorCursorUp     ControlCursorCursor = "up"
+	ControlCursorCursorDown   ControlCursorCursor = "down"
+	ControlCursorCursorLeft   ControlCursorCursor = "left"
+	ControlCursorCursorRight  ControlCursorCursor = "right"
+	ControlCursorCursorSelect ControlCursorCursor = "select"
+	ControlCursorCursorReturn ControlCursorCursor = "return"
+)
+
+// Values returns every ControlCursorCursor the spec allows.
+func (ControlCursorCursor) Values() []ControlCursorCursor {
+	return []ControlCursorCursor{ControlCursorCursorUp, ControlCursorCursorDown, ControlCursorCursorLeft, ControlCursorCursorRight, ControlCursorCursorSelect, ControlCursorCursorReturn}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ControlCursorCursor) Valid() bool {
+	switch v {
+	case ControlCursorCursorUp, ControlCursorCursorDown, ControlCursorCursorLeft, ControlCursorCursorRight, ControlCursorCursorSelect, ControlCursorCursorReturn:
+		return true
+	}
+	return false
+}
+
+// ExecuteMenuMenu is the menu parameter of executeMenu.
+type ExecuteMenuMenu string
+
+const (
+	ExecuteMenuMenuOnScreen ExecuteMenuMenu = "on_screen"
+	ExecuteMenuMenuTopMenu  ExecuteMenuMenu = "top_menu"
+	ExecuteMenuMenuMenu     ExecuteMenuMenu = "menu"
+	ExecuteMenuMenuOption   ExecuteMenuMenu = "option"
+	ExecuteMenuMenuDisplay  ExecuteMenuMenu = "display"
+	ExecuteMenuMenuHelp     ExecuteMenuMenu = "help"
+	ExecuteMenuMenuHome     ExecuteMenuMenu = "home"
+	ExecuteMenuMenuMode     ExecuteMenuMenu = "mode"
+	ExecuteMenuMenuRed      ExecuteMenuMenu = "red"
+	ExecuteMenuMenuGreen    ExecuteMenuMenu = "green"
+	ExecuteMenuMenuYellow   ExecuteMenuMenu = "yellow"
+	ExecuteMenuMenuBlue     ExecuteMenuMenu = "blue"
+)
+
+// Values returns every ExecuteMenuMenu the spec allows.
+func (ExecuteMenuMenu) Values() []ExecuteMenuMenu {
+	return []ExecuteMenuMenu{ExecuteMenuMenuOnScreen, ExecuteMenuMenuTopMenu, ExecuteMenuMenuMenu, ExecuteMenuMenuOption, ExecuteMenuMenuDisplay, ExecuteMenuMenuHelp, ExecuteMenuMenuHome, ExecuteMenuMenuMode, ExecuteMenuMenuRed, ExecuteMenuMenuGreen, ExecuteMenuMenuYellow, ExecuteMenuMenuBlue}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ExecuteMenuMenu) Valid() bool {
+	switch v {
+	case ExecuteMenuMenuOnScreen, ExecuteMenuMenuTopMenu, ExecuteMenuMenuMenu, ExecuteMenuMenuOption, ExecuteMenuMenuDisplay, ExecuteMenuMenuHelp, ExecuteMenuMenuHome, ExecuteMenuMenuMode, ExecuteMenuMenuRed, ExecuteMenuMenuGreen, ExecuteMenuMenuYellow, ExecuteMenuMenuBlue:
+		return true
+	}
+	return false
+}
+
+// GetAccountStatusResponse is the response of getAccountStatus.
+type GetAccountStatusResponse struct {
+	ResponseCode *ResponseCode `json:"response_code,omitempty"`
+}
+
+// GetBluetoothDeviceListResponseDeviceListItemType is the type field of GetBluetoothDeviceListResponseDeviceListItem.
+type GetBluetoothDeviceListResponseDeviceListItemType string
+
+const (
+	GetBluetoothDeviceListResponseDeviceListItemTypeLoudspeaker     GetBluetoothDeviceListResponseDeviceListItemType = "loudspeaker"
+	GetBluetoothDeviceListResponseDeviceListItemTypeHeadphone       GetBluetoothDeviceListResponseDeviceListItemType = "headphone"
+	GetBluetoothDeviceListResponseDeviceListItemTypeHandsfreeDevice GetBluetoothDeviceListResponseDeviceListItemType = "handsfree_device"
+	GetBluetoothDeviceListResponseDeviceListItemTypeUnknown         GetBluetoothDeviceListResponseDeviceListItemType = "unknown"
+)
+
+// Values returns every GetBluetoothDeviceListResponseDeviceListItemType the spec allows.
+func (GetBluetoothDeviceListResponseDeviceListItemType) Values() []GetBluetoothDeviceListResponseDeviceListItemType {
+	return []GetBluetoothDeviceListResponseDeviceListItemType{GetBluetoothDeviceListResponseDeviceListItemTypeLoudspeaker, GetBluetoothDeviceListResponseDeviceListItemTypeHeadphone, GetBluetoothDeviceListResponseDeviceListItemTypeHandsfreeDevice, GetBluetoothDeviceListResponseDeviceListItemTypeUnknown}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v GetBluetoothDeviceListResponseDeviceListItemType) Valid() bool {
+	switch v {
+	case GetBluetoothDeviceListResponseDeviceListItemTypeLoudspeaker, GetBluetoothDeviceListResponseDeviceListItemTypeHeadphone, GetBluetoothDeviceListResponseDeviceListItemTypeHandsfreeDevice, GetBluetoothDeviceListResponseDeviceListItemTypeUnknown:
+		return true
+	}
+	return false
+}
+
+// GetBluetoothDeviceListResponseDeviceListItem is an element of the device_list field of GetBluetoothDeviceListResponse.
+type GetBluetoothDeviceListResponseDeviceListItem struct {
+	Address *string                                           `json:"address,omitempty"`
+	Name    *string                                           `json:"name,omitempty"`
+	Type    *GetBluetoothDeviceListResponseDeviceListItemType `json:"type,omitempty"`
+}
+
+// GetBluetoothDeviceListResponse is the response of getBluetoothDeviceList.
+type GetBluetoothDeviceListResponse struct {
+	DeviceList   []GetBluetoothDeviceListResponseDeviceListItem `json:"device_list,omitempty"`
+	ResponseCode *ResponseCode                                  `json:"response_code,omitempty"`
+}
+
+// GetLocationInfoResponse is the response of getLocationInfo.
+type GetLocationInfoResponse struct {
+	// Location ID (32 characters)
+	ID           *string        `json:"id,omitempty"`
+	Name         *string        `json:"name,omitempty"`
+	ResponseCode *ResponseCode  `json:"response_code,omitempty"`
+	ZoneList     map[string]any `json:"zone_list,omitempty"`
+}
+
+// GetNameTextResponse is the response of getNameText.
+type GetNameTextResponse struct {
+	ResponseCode *ResponseCode `json:"response_code,omitempty"`
+	Text         *string       `json:"text,omitempty"`
+}
+
+// GetNetUSBPlayDescriptionType is the type parameter of getNetUsbPlayDescription.
+type GetNetUSBPlayDescriptionType string
+
+const (
+	GetNetUSBPlayDescriptionTypeWhyThisSong GetNetUSBPlayDescriptionType = "why_this_song"
+)
+
+// Values returns every GetNetUSBPlayDescriptionType the spec allows.
+func (GetNetUSBPlayDescriptionType) Values() []GetNetUSBPlayDescriptionType {
+	return []GetNetUSBPlayDescriptionType{GetNetUSBPlayDescriptionTypeWhyThisSong}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v GetNetUSBPlayDescriptionType) Valid() bool {
+	switch v {
+	case GetNetUSBPlayDescriptionTypeWhyThisSong:
+		return true
+	}
+	return false
+}
+
+// GetNetUSBPlayDescriptionResponse is the response of getNetUsbPlayDescription.
+type GetNetUSBPlayDescriptionResponse struct {
+	Description  *string       `json:"description,omitempty"`
+	ResponseCode *ResponseCode `json:"response_code,omitempty"`
+}
+
+// GetNetUSBSettingsResponse is the response of getNetUsbSettings.
+type GetNetUSBSettingsResponse struct {
+	ResponseCode *ResponseCode `json:"response_code,omitempty"`
+}
+
+// GetNetworkStandbyResponseNetworkStandby is the network_standby field of GetNetworkStandbyResponse.
+type GetNetworkStandbyResponseNetworkStandby string
+
+const (
+	GetNetworkStandbyResponseNetworkStandbyOff  GetNetworkStandbyResponseNetworkStandby = "off"
+	GetNetworkStandbyResponseNetworkStandbyOn   GetNetworkStandbyResponseNetworkStandby = "on"
+	GetNetworkStandbyResponseNetworkStandbyAuto GetNetworkStandbyResponseNetworkStandby = "auto"
+)
+
+// Values returns every GetNetworkStandbyResponseNetworkStandby the spec allows.
+func (GetNetworkStandbyResponseNetworkStandby) Values() []GetNetworkStandbyResponseNetworkStandby {
+	return []GetNetworkStandbyResponseNetworkStandby{GetNetworkStandbyResponseNetworkStandbyOff, GetNetworkStandbyResponseNetworkStandbyOn, GetNetworkStandbyResponseNetworkStandbyAuto}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v GetNetworkStandbyResponseNetworkStandby) Valid() bool {
+	switch v {
+	case GetNetworkStandbyResponseNetworkStandbyOff, GetNetworkStandbyResponseNetworkStandbyOn, GetNetworkStandbyResponseNetworkStandbyAuto:
+		return true
+	}
+	return false
+}
+
+// GetNetworkStandbyResponse is the response of getNetworkStandby.
+type GetNetworkStandbyResponse struct {
+	NetworkStandby *GetNetworkStandbyResponseNetworkStandby `json:"network_standby,omitempty"`
+	ResponseCode   *ResponseCode                            `json:"response_code,omitempty"`
+}
+
+// GetRecentInfoResponseRecentInfoItem is an element of the recent_info field of GetRecentInfoResponse.
+type GetRecentInfoResponseRecentInfoItem struct {
+	AlbumartURL *string `json:"albumart_url,omitempty"`
+	Attribute   *int    `json:"attribute,omitempty"`
+	Input       *string `json:"input,omitempty"`
+	Text        *string `json:"text,omitempty"`
+}
+
+// GetRecentInfoResponse is the response of getRecentInfo.
+type GetRecentInfoResponse struct {
+	RecentInfo   []GetRecentInfoResponseRecentInfoItem `json:"recent_info,omitempty"`
+	ResponseCode *ResponseCode                         `json:"response_code,omitempty"`
+}
+
+// GetRemoteInfoResponseMACAddress is the mac_address field of GetRemoteInfoResponse.
+type GetRemoteInfoResponseMACAddress struct {
+	WiredLAN       *string `json:"wired_lan,omitempty"`
+	WirelessDirect *string `json:"wireless_direct,omitempty"`
+	WirelessLAN    *string `json:"wireless_lan,omitempty"`
+}
+
+// GetRemoteInfoResponseNetworkStandby is the network_standby field of GetRemoteInfoResponse.
+type GetRemoteInfoResponseNetworkStandby string
+
+const (
+	GetRemoteInfoResponseNetworkStandbyOff  GetRemoteInfoResponseNetworkStandby = "off"
+	GetRemoteInfoResponseNetworkStandbyOn   GetRemoteInfoResponseNetworkStandby = "on"
+	GetRemoteInfoResponseNetworkStandbyAuto GetRemoteInfoResponseNetworkStandby = "auto"
+)
+
+// Values returns every GetRemoteInfoResponseNetworkStandby the spec allows.
+func (GetRemoteInfoResponseNetworkStandby) Values() []GetRemoteInfoResponseNetworkStandby {
+	return []GetRemoteInfoResponseNetworkStandby{GetRemoteInfoResponseNetworkStandbyOff, GetRemoteInfoResponseNetworkStandbyOn, GetRemoteInfoResponseNetworkStandbyAuto}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v GetRemoteInfoResponseNetworkStandby) Valid() bool {
+	switch v {
+	case GetRemoteInfoResponseNetworkStandbyOff, GetRemoteInfoResponseNetworkStandbyOn, GetRemoteInfoResponseNetworkStandbyAuto:
+		return true
+	}
+	return false
+}
+
+// GetRemoteInfoResponse is the response of getRemoteInfo.
+type GetRemoteInfoResponse struct {
+	APIVersion        *float64                             `json:"api_version,omitempty"`
+	Destination       *string                              `json:"destination,omitempty"`
+	IPAddress         *string                              `json:"ip_address,omitempty"`
+	MACAddress        *GetRemoteInfoResponseMACAddress     `json:"mac_address,omitempty"`
+	Main              map[string]any                       `json:"main,omitempty"`
+	Manufacture       *string                              `json:"manufacture,omitempty"`
+	ModelName         *string                              `json:"model_name,omitempty"`
+	NetmoduleChecksum *string                              `json:"netmodule_checksum,omitempty"`
+	NetmoduleVersion  *string                              `json:"netmodule_version,omitempty"`
+	NetworkStandby    *GetRemoteInfoResponseNetworkStandby `json:"network_standby,omitempty"`
+	ResponseCode      *ResponseCode                        `json:"response_code,omitempty"`
+	SerialNumber      *string                              `json:"serial_number,omitempty"`
+	SystemVersion     *float64                             `json:"system_version,omitempty"`
+	Zone2             map[string]any                       `json:"zone2,omitempty"`
+	Zone3             map[string]any                       `json:"zone3,omitempty"`
+	Zone4             map[string]any                       `json:"zone4,omitempty"`
+}
+
+// GetServiceInfoResponse is the response of getServiceInfo.
+type GetServiceInfoResponse struct {
+	ResponseCode *ResponseCode `json:"response_code,omitempty"`
+}
+
+// GetSoundProgramListResponse is the response of getSoundProgramList.
+type GetSoundProgramListResponse struct {
+	ResponseCode     *ResponseCode `json:"response_code,omitempty"`
+	SoundProgramList []string      `json:"sound_program_list,omitempty"`
+}
+
+// GetTunerPresetInfoBand is the band parameter of getTunerPresetInfo.
+type GetTunerPresetInfoBand string
+
+const (
+	GetTunerPresetInfoBandCommon GetTunerPresetInfoBand = "common"
+	GetTunerPresetInfoBandAM     GetTunerPresetInfoBand = "am"
+	GetTunerPresetInfoBandFM     GetTunerPresetInfoBand = "fm"
+	GetTunerPresetInfoBandDAB    GetTunerPresetInfoBand = "dab"
+)
+
+// Values returns every GetTunerPresetInfoBand the spec allows.
+func (GetTunerPresetInfoBand) Values() []GetTunerPresetInfoBand {
+	return []GetTunerPresetInfoBand{GetTunerPresetInfoBandCommon, GetTunerPresetInfoBandAM, GetTunerPresetInfoBandFM, GetTunerPresetInfoBandDAB}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v GetTunerPresetInfoBand) Valid() bool {
+	switch v {
+	case GetTunerPresetInfoBandCommon, GetTunerPresetInfoBandAM, GetTunerPresetInfoBandFM, GetTunerPresetInfoBandDAB:
+		return true
+	}
+	return false
+}
+
+// GetZoneSignalInfoResponseAudio is the audio field of GetZoneSignalInfoResponse.
+type GetZoneSignalInfoResponseAudio struct {
+	Error  *int    `json:"error,omitempty"`
+	Format *string `json:"format,omitempty"`
+	Fs     *string `json:"fs,omitempty"`
+}
+
+// GetZoneSignalInfoResponse is the response of getZoneSignalInfo.
+type GetZoneSignalInfoResponse struct {
+	Audio        *GetZoneSignalInfoResponseAudio `json:"audio,omitempty"`
+	ResponseCode *ResponseCode                   `json:"response_code,omitempty"`
+}
+
+// ManageNetUSBListListID is the list_id parameter of manageNetUsbList.
+type ManageNetUSBListListID string
+
+const (
+	ManageNetUSBListListIDMain         ManageNetUSBListListID = "main"
+	ManageNetUSBListListIDAutoComplete ManageNetUSBListListID = "auto_complete"
+	ManageNetUSBListListIDSearchArtist ManageNetUSBListListID = "search_artist"
+	ManageNetUSBListListIDSearchTrack  ManageNetUSBListListID = "search_track"
+)
+
+// Values returns every ManageNetUSBListListID the spec allows.
+func (ManageNetUSBListListID) Values() []ManageNetUSBListListID {
+	return []ManageNetUSBListListID{ManageNetUSBListListIDMain, ManageNetUSBListListIDAutoComplete, ManageNetUSBListListIDSearchArtist, ManageNetUSBListListIDSearchTrack}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ManageNetUSBListListID) Valid() bool {
+	switch v {
+	case ManageNetUSBListListIDMain, ManageNetUSBListListIDAutoComplete, ManageNetUSBListListIDSearchArtist, ManageNetUSBListListIDSearchTrack:
+		return true
+	}
+	return false
+}
+
+// ManageNetUSBListType is the type parameter of manageNetUsbList.
+type ManageNetUSBListType string
+
+const (
+	ManageNetUSBListTypeAddBookmark        ManageNetUSBListType = "add_bookmark"
+	ManageNetUSBListTypeAddTrack           ManageNetUSBListType = "add_track"
+	ManageNetUSBListTypeAddAlbum           ManageNetUSBListType = "add_album"
+	ManageNetUSBListTypeAddArtist          ManageNetUSBListType = "add_artist"
+	ManageNetUSBListTypeAddChannel         ManageNetUSBListType = "add_channel"
+	ManageNetUSBListTypeAddPlaylist        ManageNetUSBListType = "add_playlist"
+	ManageNetUSBListTypeRemoveBookmark     ManageNetUSBListType = "remove_bookmark"
+	ManageNetUSBListTypeRemoveTrack        ManageNetUSBListType = "remove_track"
+	ManageNetUSBListTypeRemoveAlbum        ManageNetUSBListType = "remove_album"
+	ManageNetUSBListTypeRemoveArtist       ManageNetUSBListType = "remove_artist"
+	ManageNetUSBListTypeRemoveChannel      ManageNetUSBListType = "remove_channel"
+	ManageNetUSBListTypeRemovePlaylist     ManageNetUSBListType = "remove_playlist"
+	ManageNetUSBListTypeRemoveFromPlaylist ManageNetUSBListType = "remove_from_playlist"
+	ManageNetUSBListTypeEndAutoComplete    ManageNetUSBListType = "end_auto_complete"
+)
+
+// Values returns every ManageNetUSBListType the spec allows.
+func (ManageNetUSBListType) Values() []ManageNetUSBListType {
+	return []ManageNetUSBListType{ManageNetUSBListTypeAddBookmark, ManageNetUSBListTypeAddTrack, ManageNetUSBListTypeAddAlbum, ManageNetUSBListTypeAddArtist, ManageNetUSBListTypeAddChannel, ManageNetUSBListTypeAddPlaylist, ManageNetUSBListTypeRemoveBookmark, ManageNetUSBListTypeRemoveTrack, ManageNetUSBListTypeRemoveAlbum, ManageNetUSBListTypeRemoveArtist, ManageNetUSBListTypeRemoveChannel, ManageNetUSBListTypeRemovePlaylist, ManageNetUSBListTypeRemoveFromPlaylist, ManageNetUSBListTypeEndAutoComplete}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ManageNetUSBListType) Valid() bool {
+	switch v {
+	case ManageNetUSBListTypeAddBookmark, ManageNetUSBListTypeAddTrack, ManageNetUSBListTypeAddAlbum, ManageNetUSBListTypeAddArtist, ManageNetUSBListTypeAddChannel, ManageNetUSBListTypeAddPlaylist, ManageNetUSBListTypeRemoveBookmark, ManageNetUSBListTypeRemoveTrack, ManageNetUSBListTypeRemoveAlbum, ManageNetUSBListTypeRemoveArtist, ManageNetUSBListTypeRemoveChannel, ManageNetUSBListTypeRemovePlaylist, ManageNetUSBListTypeRemoveFromPlaylist, ManageNetUSBListTypeEndAutoComplete:
+		return true
+	}
+	return false
+}
+
+// ManageNetUSBPlayType is the type parameter of manageNetUsbPlay.
+type ManageNetUSBPlayType string
+
+const (
+	ManageNetUSBPlayTypeAddBookmark      ManageNetUSBPlayType = "add_bookmark"
+	ManageNetUSBPlayTypeAddTrack         ManageNetUSBPlayType = "add_track"
+	ManageNetUSBPlayTypeAddAlbum         ManageNetUSBPlayType = "add_album"
+	ManageNetUSBPlayTypeAddChannelTrack  ManageNetUSBPlayType = "add_channel_track"
+	ManageNetUSBPlayTypeAddChannelArtist ManageNetUSBPlayType = "add_channel_artist"
+	ManageNetUSBPlayTypeAddPlaylist      ManageNetUSBPlayType = "add_playlist"
+	ManageNetUSBPlayTypeAddToPlaylist    ManageNetUSBPlayType = "add_to_playlist"
+	ManageNetUSBPlayTypeThumbsUp         ManageNetUSBPlayType = "thumbs_up"
+	ManageNetUSBPlayTypeThumbsDown       ManageNetUSBPlayType = "thumbs_down"
+	ManageNetUSBPlayTypeMarkTired        ManageNetUSBPlayType = "mark_tired"
+)
+
+// Values returns every ManageNetUSBPlayType the spec allows.
+func (ManageNetUSBPlayType) Values() []ManageNetUSBPlayType {
+	return []ManageNetUSBPlayType{ManageNetUSBPlayTypeAddBookmark, ManageNetUSBPlayTypeAddTrack, ManageNetUSBPlayTypeAddAlbum, ManageNetUSBPlayTypeAddChannelTrack, ManageNetUSBPlayTypeAddChannelArtist, ManageNetUSBPlayTypeAddPlaylist, ManageNetUSBPlayTypeAddToPlaylist, ManageNetUSBPlayTypeThumbsUp, ManageNetUSBPlayTypeThumbsDown, ManageNetUSBPlayTypeMarkTired}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v ManageNetUSBPlayType) Valid() bool {
+	switch v {
+	case ManageNetUSBPlayTypeAddBookmark, ManageNetUSBPlayTypeAddTrack, ManageNetUSBPlayTypeAddAlbum, ManageNetUSBPlayTypeAddChannelTrack, ManageNetUSBPlayTypeAddChannelArtist, ManageNetUSBPlayTypeAddPlaylist, ManageNetUSBPlayTypeAddToPlaylist, ManageNetUSBPlayTypeThumbsUp, ManageNetUSBPlayTypeThumbsDown, ManageNetUSBPlayTypeMarkTired:
+		return true
+	}
+	return false
+}
+
+// MoveTunerPresetBand is the band parameter of moveTunerPreset.
+type MoveTunerPresetBand string
+
+const (
+	MoveTunerPresetBandCommon MoveTunerPresetBand = "common"
+	MoveTunerPresetBandAM     MoveTunerPresetBand = "am"
+	MoveTunerPresetBandFM     MoveTunerPresetBand = "fm"
+	MoveTunerPresetBandDAB    MoveTunerPresetBand = "dab"
+)
+
+// Values returns every MoveTunerPresetBand the spec allows.
+func (MoveTunerPresetBand) Values() []MoveTunerPresetBand {
+	return []MoveTunerPresetBand{MoveTunerPresetBandCommon, MoveTunerPresetBandAM, MoveTunerPresetBandFM, MoveTunerPresetBandDAB}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v MoveTunerPresetBand) Valid() bool {
+	switch v {
+	case MoveTunerPresetBandCommon, MoveTunerPresetBandAM, MoveTunerPresetBandFM, MoveTunerPresetBandDAB:
+		return true
+	}
+	return false
+}
+
+// RecallTunerPresetBand is the band parameter of recallTunerPreset.
+type RecallTunerPresetBand string
+
+const (
+	RecallTunerPresetBandCommon RecallTunerPresetBand = "common"
+	RecallTunerPresetBandAM     RecallTunerPresetBand = "am"
+	RecallTunerPresetBandFM     RecallTunerPresetBand = "fm"
+	RecallTunerPresetBandDAB    RecallTunerPresetBand = "dab"
+)
+
+// Values returns every RecallTunerPresetBand the spec allows.
+func (RecallTunerPresetBand) Values() []RecallTunerPresetBand {
+	return []RecallTunerPresetBand{RecallTunerPresetBandCommon, RecallTunerPresetBandAM, RecallTunerPresetBandFM, RecallTunerPresetBandDAB}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v RecallTunerPresetBand) Valid() bool {
+	switch v {
+	case RecallTunerPresetBandCommon, RecallTunerPresetBandAM, RecallTunerPresetBandFM, RecallTunerPresetBandDAB:
+		return true
+	}
+	return false
+}
+
+// SetActualVolumeMode is the mode parameter of setActualVolume.
+type SetActualVolumeMode string
+
+const (
+	SetActualVolumeModeDb      SetActualVolumeMode = "db"
+	SetActualVolumeModeNumeric SetActualVolumeMode = "numeric"
+)
+
+// Values returns every SetActualVolumeMode the spec allows.
+func (SetActualVolumeMode) Values() []SetActualVolumeMode {
+	return []SetActualVolumeMode{SetActualVolumeModeDb, SetActualVolumeModeNumeric}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetActualVolumeMode) Valid() bool {
+	switch v {
+	case SetActualVolumeModeDb, SetActualVolumeModeNumeric:
+		return true
+	}
+	return false
+}
+
+// SetCDPlaybackPlayback is the playback parameter of setCdPlayback.
+type SetCDPlaybackPlayback string
+
+const (
+	SetCDPlaybackPlaybackPlay             SetCDPlaybackPlayback = "play"
+	SetCDPlaybackPlaybackStop             SetCDPlaybackPlayback = "stop"
+	SetCDPlaybackPlaybackPause            SetCDPlaybackPlayback = "pause"
+	SetCDPlaybackPlaybackPrevious         SetCDPlaybackPlayback = "previous"
+	SetCDPlaybackPlaybackNext             SetCDPlaybackPlayback = "next"
+	SetCDPlaybackPlaybackFastReverseStart SetCDPlaybackPlayback = "fast_reverse_start"
+	SetCDPlaybackPlaybackFastReverseEnd   SetCDPlaybackPlayback = "fast_reverse_end"
+	SetCDPlaybackPlaybackFastForwardStart SetCDPlaybackPlayback = "fast_forward_start"
+	SetCDPlaybackPlaybackFastForwardEnd   SetCDPlaybackPlayback = "fast_forward_end"
+	SetCDPlaybackPlaybackTrackSelect      SetCDPlaybackPlayback = "track_select"
+)
+
+// Values returns every SetCDPlaybackPlayback the spec allows.
+func (SetCDPlaybackPlayback) Values() []SetCDPlaybackPlayback {
+	return []SetCDPlaybackPlayback{SetCDPlaybackPlaybackPlay, SetCDPlaybackPlaybackStop, SetCDPlaybackPlaybackPause, SetCDPlaybackPlaybackPrevious, SetCDPlaybackPlaybackNext, SetCDPlaybackPlaybackFastReverseStart, SetCDPlaybackPlaybackFastReverseEnd, SetCDPlaybackPlaybackFastForwardStart, SetCDPlaybackPlaybackFastForwardEnd, SetCDPlaybackPlaybackTrackSelect}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetCDPlaybackPlayback) Valid() bool {
+	switch v {
+	case SetCDPlaybackPlaybackPlay, SetCDPlaybackPlaybackStop, SetCDPlaybackPlaybackPause, SetCDPlaybackPlaybackPrevious, SetCDPlaybackPlaybackNext, SetCDPlaybackPlaybackFastReverseStart, SetCDPlaybackPlaybackFastReverseEnd, SetCDPlaybackPlaybackFastForwardStart, SetCDPlaybackPlaybackFastForwardEnd, SetCDPlaybackPlaybackTrackSelect:
+		return true
+	}
+	return false
+}
+
+// SetCDRepeatMode is the mode parameter of setCdRepeat.
+type SetCDRepeatMode string
+
+const (
+	SetCDRepeatModeOff    SetCDRepeatMode = "off"
+	SetCDRepeatModeOne    SetCDRepeatMode = "one"
+	SetCDRepeatModeAll    SetCDRepeatMode = "all"
+	SetCDRepeatModeFolder SetCDRepeatMode = "folder"
+	SetCDRepeatModeAB     SetCDRepeatMode = "a-b"
+)
+
+// Values returns every SetCDRepeatMode the spec allows.
+func (SetCDRepeatMode) Values() []SetCDRepeatMode {
+	return []SetCDRepeatMode{SetCDRepeatModeOff, SetCDRepeatModeOne, SetCDRepeatModeAll, SetCDRepeatModeFolder, SetCDRepeatModeAB}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetCDRepeatMode) Valid() bool {
+	switch v {
+	case SetCDRepeatModeOff, SetCDRepeatModeOne, SetCDRepeatModeAll, SetCDRepeatModeFolder, SetCDRepeatModeAB:
+		return true
+	}
+	return false
+}
+
+// SetCDShuffleMode is the mode parameter of setCdShuffle.
+type SetCDShuffleMode string
+
+const (
+	SetCDShuffleModeOff     SetCDShuffleMode = "off"
+	SetCDShuffleModeOn      SetCDShuffleMode = "on"
+	SetCDShuffleModeFolder  SetCDShuffleMode = "folder"
+	SetCDShuffleModeProgram SetCDShuffleMode = "program"
+)
+
+// Values returns every SetCDShuffleMode the spec allows.
+func (SetCDShuffleMode) Values() []SetCDShuffleMode {
+	return []SetCDShuffleMode{SetCDShuffleModeOff, SetCDShuffleModeOn, SetCDShuffleModeFolder, SetCDShuffleModeProgram}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetCDShuffleMode) Valid() bool {
+	switch v {
+	case SetCDShuffleModeOff, SetCDShuffleModeOn, SetCDShuffleModeFolder, SetCDShuffleModeProgram:
+		return true
+	}
+	return false
+}
+
+// SetClockFormatFormat is the format parameter of setClockFormat.
+type SetClockFormatFormat string
+
+const (
+	SetClockFormatFormat12h SetClockFormatFormat = "12h"
+	SetClockFormatFormat24h SetClockFormatFormat = "24h"
+)
+
+// Values returns every SetClockFormatFormat the spec allows.
+func (SetClockFormatFormat) Values() []SetClockFormatFormat {
+	return []SetClockFormatFormat{SetClockFormatFormat12h, SetClockFormatFormat24h}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetClockFormatFormat) Valid() bool {
+	switch v {
+	case SetClockFormatFormat12h, SetClockFormatFormat24h:
+		return true
+	}
+	return false
+}
+
+// SetDABServiceDir is the dir parameter of setDabService.
+type SetDABServiceDir string
+
+const (
+	SetDABServiceDirNext     SetDABServiceDir = "next"
+	SetDABServiceDirPrevious SetDABServiceDir = "previous"
+)
+
+// Values returns every SetDABServiceDir the spec allows.
+func (SetDABServiceDir) Values() []SetDABServiceDir {
+	return []SetDABServiceDir{SetDABServiceDirNext, SetDABServiceDirPrevious}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetDABServiceDir) Valid() bool {
+	switch v {
+	case SetDABServiceDirNext, SetDABServiceDirPrevious:
+		return true
+	}
+	return false
+}
+
+// SetGroupNameRequest is the request body of setGroupName.
+type SetGroupNameRequest struct {
+	// Group name (UTF-8, max 128 bytes)
+	Name string `json:"name"`
+}
+
+// SetLinkAudioDelayDelay is the delay parameter of setLinkAudioDelay.
+type SetLinkAudioDelayDelay string
+
+const (
+	SetLinkAudioDelayDelayLipSync      SetLinkAudioDelayDelay = "lip_sync"
+	SetLinkAudioDelayDelayAudioSync    SetLinkAudioDelayDelay = "audio_sync"
+	SetLinkAudioDelayDelayAudioSyncOn  SetLinkAudioDelayDelay = "audio_sync_on"
+	SetLinkAudioDelayDelayAudioSyncOff SetLinkAudioDelayDelay = "audio_sync_off"
+	SetLinkAudioDelayDelayBalanced     SetLinkAudioDelayDelay = "balanced"
+)
+
+// Values returns every SetLinkAudioDelayDelay the spec allows.
+func (SetLinkAudioDelayDelay) Values() []SetLinkAudioDelayDelay {
+	return []SetLinkAudioDelayDelay{SetLinkAudioDelayDelayLipSync, SetLinkAudioDelayDelayAudioSync, SetLinkAudioDelayDelayAudioSyncOn, SetLinkAudioDelayDelayAudioSyncOff, SetLinkAudioDelayDelayBalanced}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetLinkAudioDelayDelay) Valid() bool {
+	switch v {
+	case SetLinkAudioDelayDelayLipSync, SetLinkAudioDelayDelayAudioSync, SetLinkAudioDelayDelayAudioSyncOn, SetLinkAudioDelayDelayAudioSyncOff, SetLinkAudioDelayDelayBalanced:
+		return true
+	}
+	return false
+}
+
+// SetLinkAudioQualityQuality is the quality parameter of setLinkAudioQuality.
+type SetLinkAudioQualityQuality string
+
+const (
+	SetLinkAudioQualityQualityCompressed   SetLinkAudioQualityQuality = "compressed"
+	SetLinkAudioQualityQualityUncompressed SetLinkAudioQualityQuality = "uncompressed"
+)
+
+// Values returns every SetLinkAudioQualityQuality the spec allows.
+func (SetLinkAudioQualityQuality) Values() []SetLinkAudioQualityQuality {
+	return []SetLinkAudioQualityQuality{SetLinkAudioQualityQualityCompressed, SetLinkAudioQualityQualityUncompressed}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetLinkAudioQualityQuality) Valid() bool {
+	switch v {
+	case SetLinkAudioQualityQualityCompressed, SetLinkAudioQualityQualityUncompressed:
+		return true
+	}
+	return false
+}
+
+// SetLinkControlControl is the control parameter of setLinkControl.
+type SetLinkControlControl string
+
+const (
+	SetLinkControlControlStandard  SetLinkControlControl = "standard"
+	SetLinkControlControlStability SetLinkControlControl = "stability"
+	SetLinkControlControlSpeed     SetLinkControlControl = "speed"
+)
+
+// Values returns every SetLinkControlControl the spec allows.
+func (SetLinkControlControl) Values() []SetLinkControlControl {
+	return []SetLinkControlControl{SetLinkControlControlStandard, SetLinkControlControlStability, SetLinkControlControlSpeed}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetLinkControlControl) Valid() bool {
+	switch v {
+	case SetLinkControlControlStandard, SetLinkControlControlStability, SetLinkControlControlSpeed:
+		return true
+	}
+	return false
+}
+
+// SetNetUSBListControlListID is the list_id parameter of setNetUsbListControl.
+type SetNetUSBListControlListID string
+
+const (
+	SetNetUSBListControlListIDMain         SetNetUSBListControlListID = "main"
+	SetNetUSBListControlListIDAutoComplete SetNetUSBListControlListID = "auto_complete"
+	SetNetUSBListControlListIDSearchArtist SetNetUSBListControlListID = "search_artist"
+	SetNetUSBListControlListIDSearchTrack  SetNetUSBListControlListID = "search_track"
+)
+
+// Values returns every SetNetUSBListControlListID the spec allows.
+func (SetNetUSBListControlListID) Values() []SetNetUSBListControlListID {
+	return []SetNetUSBListControlListID{SetNetUSBListControlListIDMain, SetNetUSBListControlListIDAutoComplete, SetNetUSBListControlListIDSearchArtist, SetNetUSBListControlListIDSearchTrack}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetNetUSBListControlListID) Valid() bool {
+	switch v {
+	case SetNetUSBListControlListIDMain, SetNetUSBListControlListIDAutoComplete, SetNetUSBListControlListIDSearchArtist, SetNetUSBListControlListIDSearchTrack:
+		return true
+	}
+	return false
+}
+
+// SetNetUSBListControlType is the type parameter of setNetUsbListControl.
+type SetNetUSBListControlType string
+
+const (
+	SetNetUSBListControlTypeSelect SetNetUSBListControlType = "select"
+	SetNetUSBListControlTypePlay   SetNetUSBListControlType = "play"
+	SetNetUSBListControlTypeReturn SetNetUSBListControlType = "return"
+)
+
+// Values returns every SetNetUSBListControlType the spec allows.
+func (SetNetUSBListControlType) Values() []SetNetUSBListControlType {
+	return []SetNetUSBListControlType{SetNetUSBListControlTypeSelect, SetNetUSBListControlTypePlay, SetNetUSBListControlTypeReturn}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetNetUSBListControlType) Valid() bool {
+	switch v {
+	case SetNetUSBListControlTypeSelect, SetNetUSBListControlTypePlay, SetNetUSBListControlTypeReturn:
+		return true
+	}
+	return false
+}
+
+// SetNetUSBPlaybackPlayback is the playback parameter of setNetUsbPlayback.
+type SetNetUSBPlaybackPlayback string
+
+const (
+	SetNetUSBPlaybackPlaybackPlay             SetNetUSBPlaybackPlayback = "play"
+	SetNetUSBPlaybackPlaybackStop             SetNetUSBPlaybackPlayback = "stop"
+	SetNetUSBPlaybackPlaybackPause            SetNetUSBPlaybackPlayback = "pause"
+	SetNetUSBPlaybackPlaybackPlayPause        SetNetUSBPlaybackPlayback = "play_pause"
+	SetNetUSBPlaybackPlaybackPrevious         SetNetUSBPlaybackPlayback = "previous"
+	SetNetUSBPlaybackPlaybackNext             SetNetUSBPlaybackPlayback = "next"
+	SetNetUSBPlaybackPlaybackFastReverseStart SetNetUSBPlaybackPlayback = "fast_reverse_start"
+	SetNetUSBPlaybackPlaybackFastReverseEnd   SetNetUSBPlaybackPlayback = "fast_reverse_end"
+	SetNetUSBPlaybackPlaybackFastForwardStart SetNetUSBPlaybackPlayback = "fast_forward_start"
+	SetNetUSBPlaybackPlaybackFastForwardEnd   SetNetUSBPlaybackPlayback = "fast_forward_end"
+)
+
+// Values returns every SetNetUSBPlaybackPlayback the spec allows.
+func (SetNetUSBPlaybackPlayback) Values() []SetNetUSBPlaybackPlayback {
+	return []SetNetUSBPlaybackPlayback{SetNetUSBPlaybackPlaybackPlay, SetNetUSBPlaybackPlaybackStop, SetNetUSBPlaybackPlaybackPause, SetNetUSBPlaybackPlaybackPlayPause, SetNetUSBPlaybackPlaybackPrevious, SetNetUSBPlaybackPlaybackNext, SetNetUSBPlaybackPlaybackFastReverseStart, SetNetUSBPlaybackPlaybackFastReverseEnd, SetNetUSBPlaybackPlaybackFastForwardStart, SetNetUSBPlaybackPlaybackFastForwardEnd}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetNetUSBPlaybackPlayback) Valid() bool {
+	switch v {
+	case SetNetUSBPlaybackPlaybackPlay, SetNetUSBPlaybackPlaybackStop, SetNetUSBPlaybackPlaybackPause, SetNetUSBPlaybackPlaybackPlayPause, SetNetUSBPlaybackPlaybackPrevious, SetNetUSBPlaybackPlaybackNext, SetNetUSBPlaybackPlaybackFastReverseStart, SetNetUSBPlaybackPlaybackFastReverseEnd, SetNetUSBPlaybackPlaybackFastForwardStart, SetNetUSBPlaybackPlaybackFastForwardEnd:
+		return true
+	}
+	return false
+}
+
+// SetNetUSBRepeatMode is the mode parameter of setNetUsbRepeat.
+type SetNetUSBRepeatMode string
+
+const (
+	SetNetUSBRepeatModeOff SetNetUSBRepeatMode = "off"
+	SetNetUSBRepeatModeOne SetNetUSBRepeatMode = "one"
+	SetNetUSBRepeatModeAll SetNetUSBRepeatMode = "all"
+)
+
+// Values returns every SetNetUSBRepeatMode the spec allows.
+func (SetNetUSBRepeatMode) Values() []SetNetUSBRepeatMode {
+	return []SetNetUSBRepeatMode{SetNetUSBRepeatModeOff, SetNetUSBRepeatModeOne, SetNetUSBRepeatModeAll}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetNetUSBRepeatMode) Valid() bool {
+	switch v {
+	case SetNetUSBRepeatModeOff, SetNetUSBRepeatModeOne, SetNetUSBRepeatModeAll:
+		return true
+	}
+	return false
+}
+
+// SetNetUSBSearchStringRequest is the request body of setNetUsbSearchString.
+type SetNetUSBSearchStringRequest struct {
+	ListID *string `json:"list_id,omitempty"`
+	String string  `json:"string"`
+}
+
+// SetNetUSBShuffleMode is the mode parameter of setNetUsbShuffle.
+type SetNetUSBShuffleMode string
+
+const (
+	SetNetUSBShuffleModeOff    SetNetUSBShuffleMode = "off"
+	SetNetUSBShuffleModeOn     SetNetUSBShuffleMode = "on"
+	SetNetUSBShuffleModeSongs  SetNetUSBShuffleMode = "songs"
+	SetNetUSBShuffleModeAlbums SetNetUSBShuffleMode = "albums"
+)
+
+// Values returns every SetNetUSBShuffleMode the spec allows.
+func (SetNetUSBShuffleMode) Values() []SetNetUSBShuffleMode {
+	return []SetNetUSBShuffleMode{SetNetUSBShuffleModeOff, SetNetUSBShuffleModeOn, SetNetUSBShuffleModeSongs, SetNetUSBShuffleModeAlbums}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetNetUSBShuffleMode) Valid() bool {
+	switch v {
+	case SetNetUSBShuffleModeOff, SetNetUSBShuffleModeOn, SetNetUSBShuffleModeSongs, SetNetUSBShuffleModeAlbums:
+		return true
+	}
+	return false
+}
+
+// SetNetworkStandbyStandby is the standby parameter of setNetworkStandby.
+type SetNetworkStandbyStandby string
+
+const (
+	SetNetworkStandbyStandbyOff  SetNetworkStandbyStandby = "off"
+	SetNetworkStandbyStandbyOn   SetNetworkStandbyStandby = "on"
+	SetNetworkStandbyStandbyAuto SetNetworkStandbyStandby = "auto"
+)
+
+// Values returns every SetNetworkStandbyStandby the spec allows.
+func (SetNetworkStandbyStandby) Values() []SetNetworkStandbyStandby {
+	return []SetNetworkStandbyStandby{SetNetworkStandbyStandbyOff, SetNetworkStandbyStandbyOn, SetNetworkStandbyStandbyAuto}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetNetworkStandbyStandby) Valid() bool {
+	switch v {
+	case SetNetworkStandbyStandbyOff, SetNetworkStandbyStandbyOn, SetNetworkStandbyStandbyAuto:
+		return true
+	}
+	return false
+}
+
+// SetSurroundDecoderTypeType is the type parameter of setSurroundDecoderType.
+type SetSurroundDecoderTypeType string
+
+const (
+	SetSurroundDecoderTypeTypeToggle         SetSurroundDecoderTypeType = "toggle"
+	SetSurroundDecoderTypeTypeAuto           SetSurroundDecoderTypeType = "auto"
+	SetSurroundDecoderTypeTypeDolbyPl        SetSurroundDecoderTypeType = "dolby_pl"
+	SetSurroundDecoderTypeTypeDolbyPl2xMovie SetSurroundDecoderTypeType = "dolby_pl2x_movie"
+	SetSurroundDecoderTypeTypeDolbyPl2xMusic SetSurroundDecoderTypeType = "dolby_pl2x_music"
+	SetSurroundDecoderTypeTypeDolbyPl2xGame  SetSurroundDecoderTypeType = "dolby_pl2x_game"
+	SetSurroundDecoderTypeTypeDolbySurround  SetSurroundDecoderTypeType = "dolby_surround"
+	SetSurroundDecoderTypeTypeDtsNeuralX     SetSurroundDecoderTypeType = "dts_neural_x"
+	SetSurroundDecoderTypeTypeDtsNeo6Cinema  SetSurroundDecoderTypeType = "dts_neo6_cinema"
+	SetSurroundDecoderTypeTypeDtsNeo6Music   SetSurroundDecoderTypeType = "dts_neo6_music"
+)
+
+// Values returns every SetSurroundDecoderTypeType the spec allows.
+func (SetSurroundDecoderTypeType) Values() []SetSurroundDecoderTypeType {
+	return []SetSurroundDecoderTypeType{SetSurroundDecoderTypeTypeToggle, SetSurroundDecoderTypeTypeAuto, SetSurroundDecoderTypeTypeDolbyPl, SetSurroundDecoderTypeTypeDolbyPl2xMovie, SetSurroundDecoderTypeTypeDolbyPl2xMusic, SetSurroundDecoderTypeTypeDolbyPl2xGame, SetSurroundDecoderTypeTypeDolbySurround, SetSurroundDecoderTypeTypeDtsNeuralX, SetSurroundDecoderTypeTypeDtsNeo6Cinema, SetSurroundDecoderTypeTypeDtsNeo6Music}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetSurroundDecoderTypeType) Valid() bool {
+	switch v {
+	case SetSurroundDecoderTypeTypeToggle, SetSurroundDecoderTypeTypeAuto, SetSurroundDecoderTypeTypeDolbyPl, SetSurroundDecoderTypeTypeDolbyPl2xMovie, SetSurroundDecoderTypeTypeDolbyPl2xMusic, SetSurroundDecoderTypeTypeDolbyPl2xGame, SetSurroundDecoderTypeTypeDolbySurround, SetSurroundDecoderTypeTypeDtsNeuralX, SetSurroundDecoderTypeTypeDtsNeo6Cinema, SetSurroundDecoderTypeTypeDtsNeo6Music:
+		return true
+	}
+	return false
+}
+
+// SetTunerBandBand is the band parameter of setTunerBand.
+type SetTunerBandBand string
+
+const (
+	SetTunerBandBandAM  SetTunerBandBand = "am"
+	SetTunerBandBandFM  SetTunerBandBand = "fm"
+	SetTunerBandBandDAB SetTunerBandBand = "dab"
+)
+
+// Values returns every SetTunerBandBand the spec allows.
+func (SetTunerBandBand) Values() []SetTunerBandBand {
+	return []SetTunerBandBand{SetTunerBandBandAM, SetTunerBandBandFM, SetTunerBandBandDAB}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetTunerBandBand) Valid() bool {
+	switch v {
+	case SetTunerBandBandAM, SetTunerBandBandFM, SetTunerBandBandDAB:
+		return true
+	}
+	return false
+}
+
+// SetTunerFreqBand is the band parameter of setTunerFreq.
+type SetTunerFreqBand string
+
+const (
+	SetTunerFreqBandAM SetTunerFreqBand = "am"
+	SetTunerFreqBandFM SetTunerFreqBand = "fm"
+)
+
+// Values returns every SetTunerFreqBand the spec allows.
+func (SetTunerFreqBand) Values() []SetTunerFreqBand {
+	return []SetTunerFreqBand{SetTunerFreqBandAM, SetTunerFreqBandFM}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetTunerFreqBand) Valid() bool {
+	switch v {
+	case SetTunerFreqBandAM, SetTunerFreqBandFM:
+		return true
+	}
+	return false
+}
+
+// SetTunerFreqTuning is the tuning parameter of setTunerFreq.
+type SetTunerFreqTuning string
+
+const (
+	SetTunerFreqTuningTpUp     SetTunerFreqTuning = "tp_up"
+	SetTunerFreqTuningTpDown   SetTunerFreqTuning = "tp_down"
+	SetTunerFreqTuningDirect   SetTunerFreqTuning = "direct"
+	SetTunerFreqTuningAutoUp   SetTunerFreqTuning = "auto_up"
+	SetTunerFreqTuningAutoDown SetTunerFreqTuning = "auto_down"
+	SetTunerFreqTuningCancel   SetTunerFreqTuning = "cancel"
+)
+
+// Values returns every SetTunerFreqTuning the spec allows.
+func (SetTunerFreqTuning) Values() []SetTunerFreqTuning {
+	return []SetTunerFreqTuning{SetTunerFreqTuningTpUp, SetTunerFreqTuningTpDown, SetTunerFreqTuningDirect, SetTunerFreqTuningAutoUp, SetTunerFreqTuningAutoDown, SetTunerFreqTuningCancel}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetTunerFreqTuning) Valid() bool {
+	switch v {
+	case SetTunerFreqTuningTpUp, SetTunerFreqTuningTpDown, SetTunerFreqTuningDirect, SetTunerFreqTuningAutoUp, SetTunerFreqTuningAutoDown, SetTunerFreqTuningCancel:
+		return true
+	}
+	return false
+}
+
+// SetWirelessDirectRequestType is security type.
+type SetWirelessDirectRequestType string
+
+const (
+	SetWirelessDirectRequestTypeNone       SetWirelessDirectRequestType = "none"
+	SetWirelessDirectRequestTypeWep        SetWirelessDirectRequestType = "wep"
+	SetWirelessDirectRequestTypeWpa2PskAes SetWirelessDirectRequestType = "wpa2-psk(aes)"
+	SetWirelessDirectRequestTypeMixedMode  SetWirelessDirectRequestType = "mixed_mode"
+)
+
+// Values returns every SetWirelessDirectRequestType the spec allows.
+func (SetWirelessDirectRequestType) Values() []SetWirelessDirectRequestType {
+	return []SetWirelessDirectRequestType{SetWirelessDirectRequestTypeNone, SetWirelessDirectRequestTypeWep, SetWirelessDirectRequestTypeWpa2PskAes, SetWirelessDirectRequestTypeMixedMode}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetWirelessDirectRequestType) Valid() bool {
+	switch v {
+	case SetWirelessDirectRequestTypeNone, SetWirelessDirectRequestTypeWep, SetWirelessDirectRequestTypeWpa2PskAes, SetWirelessDirectRequestTypeMixedMode:
+		return true
+	}
+	return false
+}
+
+// SetWirelessDirectRequest is the request body of setWirelessDirect.
+type SetWirelessDirectRequest struct {
+	// Security key (max 64 chars)
+	Key *string `json:"key,omitempty"`
+	// Security type
+	Type *SetWirelessDirectRequestType `json:"type,omitempty"`
+}
+
+// SetZoneEqualizerMode is the mode parameter of setZoneEqualizer.
+type SetZoneEqualizerMode string
+
+const (
+	SetZoneEqualizerModeManual SetZoneEqualizerMode = "manual"
+	SetZoneEqualizerModeAuto   SetZoneEqualizerMode = "auto"
+	SetZoneEqualizerModeBypass SetZoneEqualizerMode = "bypass"
+)
+
+// Values returns every SetZoneEqualizerMode the spec allows.
+func (SetZoneEqualizerMode) Values() []SetZoneEqualizerMode {
+	return []SetZoneEqualizerMode{SetZoneEqualizerModeManual, SetZoneEqualizerModeAuto, SetZoneEqualizerModeBypass}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetZoneEqualizerMode) Valid() bool {
+	switch v {
+	case SetZoneEqualizerModeManual, SetZoneEqualizerModeAuto, SetZoneEqualizerModeBypass:
+		return true
+	}
+	return false
+}
+
+// SetZoneInputMode is the mode parameter of setZoneInput.
+type SetZoneInputMode string
+
+const (
+	SetZoneInputModeAutoplayDisabled SetZoneInputMode = "autoplay_disabled"
+)
+
+// Values returns every SetZoneInputMode the spec allows.
+func (SetZoneInputMode) Values() []SetZoneInputMode {
+	return []SetZoneInputMode{SetZoneInputModeAutoplayDisabled}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetZoneInputMode) Valid() bool {
+	switch v {
+	case SetZoneInputModeAutoplayDisabled:
+		return true
+	}
+	return false
+}
+
+// SetZonePowerPower is the power parameter of setZonePower.
+type SetZonePowerPower string
+
+const (
+	SetZonePowerPowerOn      SetZonePowerPower = "on"
+	SetZonePowerPowerStandby SetZonePowerPower = "standby"
+	SetZonePowerPowerToggle  SetZonePowerPower = "toggle"
+)
+
+// Values returns every SetZonePowerPower the spec allows.
+func (SetZonePowerPower) Values() []SetZonePowerPower {
+	return []SetZonePowerPower{SetZonePowerPowerOn, SetZonePowerPowerStandby, SetZonePowerPowerToggle}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetZonePowerPower) Valid() bool {
+	switch v {
+	case SetZonePowerPowerOn, SetZonePowerPowerStandby, SetZonePowerPowerToggle:
+		return true
+	}
+	return false
+}
+
+// SetZoneSleepSleep is the sleep parameter of setZoneSleep.
+type SetZoneSleepSleep int
+
+const (
+	SetZoneSleepSleep0   SetZoneSleepSleep = 0
+	SetZoneSleepSleep30  SetZoneSleepSleep = 30
+	SetZoneSleepSleep60  SetZoneSleepSleep = 60
+	SetZoneSleepSleep90  SetZoneSleepSleep = 90
+	SetZoneSleepSleep120 SetZoneSleepSleep = 120
+)
+
+// Values returns every SetZoneSleepSleep the spec allows.
+func (SetZoneSleepSleep) Values() []SetZoneSleepSleep {
+	return []SetZoneSleepSleep{SetZoneSleepSleep0, SetZoneSleepSleep30, SetZoneSleepSleep60, SetZoneSleepSleep90, SetZoneSleepSleep120}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetZoneSleepSleep) Valid() bool {
+	switch v {
+	case SetZoneSleepSleep0, SetZoneSleepSleep30, SetZoneSleepSleep60, SetZoneSleepSleep90, SetZoneSleepSleep120:
+		return true
+	}
+	return false
+}
+
+// SetZoneToneControlMode is the mode parameter of setZoneToneControl.
+type SetZoneToneControlMode string
+
+const (
+	SetZoneToneControlModeManual SetZoneToneControlMode = "manual"
+	SetZoneToneControlModeAuto   SetZoneToneControlMode = "auto"
+	SetZoneToneControlModeBypass SetZoneToneControlMode = "bypass"
+)
+
+// Values returns every SetZoneToneControlMode the spec allows.
+func (SetZoneToneControlMode) Values() []SetZoneToneControlMode {
+	return []SetZoneToneControlMode{SetZoneToneControlModeManual, SetZoneToneControlModeAuto, SetZoneToneControlModeBypass}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SetZoneToneControlMode) Valid() bool {
+	switch v {
+	case SetZoneToneControlModeManual, SetZoneToneControlModeAuto, SetZoneToneControlModeBypass:
+		return true
+	}
+	return false
+}
+
+// StartAutoPresetBand is the band parameter of startAutoPreset.
+type StartAutoPresetBand string
+
+const (
+	StartAutoPresetBandFM StartAutoPresetBand = "fm"
+)
+
+// Values returns every StartAutoPresetBand the spec allows.
+func (StartAutoPresetBand) Values() []StartAutoPresetBand {
+	return []StartAutoPresetBand{StartAutoPresetBandFM}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v StartAutoPresetBand) Valid() bool {
+	switch v {
+	case StartAutoPresetBandFM:
+		return true
+	}
+	return false
+}
+
+// SwitchTunerPresetDir is the dir parameter of switchTunerPreset.
+type SwitchTunerPresetDir string
+
+const (
+	SwitchTunerPresetDirNext     SwitchTunerPresetDir = "next"
+	SwitchTunerPresetDirPrevious SwitchTunerPresetDir = "previous"
+)
+
+// Values returns every SwitchTunerPresetDir the spec allows.
+func (SwitchTunerPresetDir) Values() []SwitchTunerPresetDir {
+	return []SwitchTunerPresetDir{SwitchTunerPresetDirNext, SwitchTunerPresetDirPrevious}
+}
+
+// Valid reports whether v is one of the values the spec allows.
+func (v SwitchTunerPresetDir) Valid() bool {
+	switch v {
+	case SwitchTunerPresetDirNext, SwitchTunerPresetDirPrevious:
+		return true
+	}
+	return false
+}
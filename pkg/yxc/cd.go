@@ -0,0 +1,79 @@
+package yxc
+
+import (
+	"context"
+	"strconv"
+)
+
+type CDService struct {
+	c *Client
+}
+
+func (c *Client) CD() *CDService {
+	return &CDService{c: c}
+}
+
+type CDPlayInfo struct {
+	Response
+	DeviceStatus     string   `json:"device_status"`
+	Playback         string   `json:"playback"`
+	Repeat           string   `json:"repeat"`
+	Shuffle          string   `json:"shuffle"`
+	RepeatAvailable  []string `json:"repeat_available"`
+	ShuffleAvailable []string `json:"shuffle_available"`
+	PlayTime         int      `json:"play_time"`
+	TotalTime        int      `json:"total_time"`
+	DiscTime         int      `json:"disc_time"`
+	TrackNumber      int      `json:"track_number"`
+	TotalTracks      int      `json:"total_tracks"`
+	Artist           string   `json:"artist"`
+	Album            string   `json:"album"`
+	Track            string   `json:"track"`
+}
+
+func (d *CDService) GetPlayInfo(ctx context.Context) (*CDPlayInfo, error) {
+	out := &CDPlayInfo{}
+	return out, d.c.get(ctx, "cd/getPlayInfo", nil, out)
+}
+
+func (d *CDService) SetPlayback(ctx context.Context, playback string) (*Response, error) {
+	out := &Response{}
+	return out, d.c.get(ctx, "cd/setPlayback", setQuery("playback", playback), out)
+}
+
+func (d *CDService) SelectTrack(ctx context.Context, num int) (*Response, error) {
+	q := setQuery("playback", "track_select")
+	q.Set("num", strconv.Itoa(num))
+	out := &Response{}
+	return out, d.c.get(ctx, "cd/setPlayback", q, out)
+}
+
+func (d *CDService) ToggleTray(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, d.c.get(ctx, "cd/toggleTray", nil, out)
+}
+
+func (d *CDService) SetRepeat(ctx context.Context, mode string) (*Response, error) {
+	out := &Response{}
+	return out, d.c.get(ctx, "cd/setRepeat", setQuery("mode", mode), out)
+}
+
+func (d *CDService) SetShuffle(ctx context.Context, mode string) (*Response, error) {
+	out := &Response{}
+	return out, d.c.get(ctx, "cd/setShuffle", setQuery("mode", mode), out)
+}
+
+func (d *CDService) ToggleRepeat(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, d.c.get(ctx, "cd/toggleRepeat", nil, out)
+}
+
+func (d *CDService) ToggleShuffle(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, d.c.get(ctx, "cd/toggleShuffle", nil, out)
+}
+
+func (d *CDService) SetDirect(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, d.c.get(ctx, "cd/setDirect", enableQuery(enable), out)
+}
@@ -0,0 +1,135 @@
+// Package yxc is a typed client for the Yamaha Extended Control API exposed
+// by MusicCast receivers, sound bars and speakers.
+package yxc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type Config struct {
+	Host      string
+	BaseURL   string
+	APIPrefix string
+	Timeout   time.Duration
+	Retries   int
+	Retry     RetryPolicy
+	Throttle  Throttle
+	Auth      string
+	Headers   []string
+	Proxy     string
+}
+
+// Client talks to a single device. DryRun, when set, receives every built
+// request instead of it being sent; Trace observes each completed exchange
+// and OnRetry each retry before its delay. Validate can reject a request
+// before it is sent or printed, and CheckResponse can reject a successful
+// response, which Do then returns along with the error.
+type Client struct {
+	Config        Config
+	HTTPClient    *http.Client
+	DryRun        func(req *http.Request, body []byte) error
+	Trace         func(req *http.Request, status int)
+	OnRetry       func(method, path string, attempt int, reason error, delay time.Duration)
+	Validate      func(req *http.Request, body []byte) error
+	CheckResponse func(resp *RawResponse) error
+}
+
+func New(cfg Config) *Client {
+	return &Client{Config: cfg}
+}
+
+// Response carries the response_code common to every endpoint and keeps the
+// undecoded body so callers can see fields the typed structs do not model.
+type Response struct {
+	ResponseCode int `json:"response_code"`
+	raw          []byte
+}
+
+func (r *Response) Raw() []byte {
+	return r.raw
+}
+
+func (r *Response) base() *Response {
+	return r
+}
+
+type result interface {
+	base() *Response
+}
+
+func Int(v int) *int {
+	return &v
+}
+
+func Float(v float64) *float64 {
+	return &v
+}
+
+func (c *Client) get(ctx context.Context, path string, q url.Values, out result) error {
+	return c.invoke(ctx, http.MethodGet, path, q, nil, out)
+}
+
+func (c *Client) post(ctx context.Context, path string, payload any, out result) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return c.invoke(ctx, http.MethodPost, path, nil, body, out)
+}
+
+func (c *Client) invoke(ctx context.Context, method, path string, q url.Values, body []byte, out result) error {
+	contentType := ""
+	if len(body) > 0 {
+		contentType = "application/json"
+	}
+	endpoint := c.API(path)
+	resp, err := c.Do(ctx, method, endpoint, q, body, contentType)
+	if resp == nil {
+		return err
+	}
+	base := out.base()
+	if len(resp.Body) > 0 && json.Valid(resp.Body) {
+		if err := json.Unmarshal(resp.Body, out); err != nil {
+			base.raw = resp.Body
+			return fmt.Errorf("%s: decode response: %w", endpoint, err)
+		}
+	}
+	base.raw = resp.Body
+	if cerr := Check(endpoint, resp); cerr != nil {
+		return cerr
+	}
+	return err
+}
+
+// Check converts a failed HTTP status or a non-zero response_code into an
+// error. It is a no-op for dry-run responses.
+func Check(endpoint string, resp *RawResponse) error {
+	if resp == nil || resp.Status == 0 {
+		return nil
+	}
+	if resp.Status >= 400 {
+		return &HTTPError{Endpoint: endpoint, Status: resp.Status}
+	}
+	if code, ok := ResponseCode(resp.Body); ok && code != 0 {
+		return &ResponseError{Endpoint: endpoint, Code: code}
+	}
+	return nil
+}
+
+func enableQuery(enable bool) url.Values {
+	q := url.Values{}
+	q.Set("enable", strconv.FormatBool(enable))
+	return q
+}
+
+func setQuery(key, value string) url.Values {
+	q := url.Values{}
+	q.Set(key, value)
+	return q
+}
@@ -0,0 +1,65 @@
+package yxc
+
+import (
+	"context"
+
+	"github.com/amannm/yxc/pkg/yxc/api"
+)
+
+type ClockService struct {
+	c *Client
+}
+
+func (c *Client) Clock() *ClockService {
+	return &ClockService{c: c}
+}
+
+type ClockSettings struct {
+	Response
+	AutoSync bool   `json:"auto_sync"`
+	Format   string `json:"format"`
+	Alarm    struct {
+		AlarmOn      bool   `json:"alarm_on"`
+		Volume       int    `json:"volume"`
+		FadeInterval int    `json:"fade_interval"`
+		FadeType     int    `json:"fade_type"`
+		Mode         string `json:"mode"`
+		Repeat       bool   `json:"repeat"`
+		Oneday       struct {
+			Enable       bool   `json:"enable"`
+			Time         string `json:"time"`
+			Beep         bool   `json:"beep"`
+			PlaybackType string `json:"playback_type"`
+		} `json:"oneday"`
+		Weekly map[string]any `json:"weekly,omitempty"`
+	} `json:"alarm"`
+}
+
+// AlarmSettings is the body of setAlarmSettings, generated from the spec.
+// Fields left nil are not sent.
+type AlarmSettings = api.AlarmSettings
+
+func (k *ClockService) GetSettings(ctx context.Context) (*ClockSettings, error) {
+	out := &ClockSettings{}
+	return out, k.c.get(ctx, "clock/getSettings", nil, out)
+}
+
+func (k *ClockService) SetAutoSync(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, k.c.get(ctx, "clock/setAutoSync", enableQuery(enable), out)
+}
+
+func (k *ClockService) SetDateAndTime(ctx context.Context, dateTime string) (*Response, error) {
+	out := &Response{}
+	return out, k.c.get(ctx, "clock/setDateAndTime", setQuery("date_time", dateTime), out)
+}
+
+func (k *ClockService) SetClockFormat(ctx context.Context, format string) (*Response, error) {
+	out := &Response{}
+	return out, k.c.get(ctx, "clock/setClockFormat", setQuery("format", format), out)
+}
+
+func (k *ClockService) SetAlarmSettings(ctx context.Context, r AlarmSettings) (*Response, error) {
+	out := &Response{}
+	return out, k.c.post(ctx, "clock/setAlarmSettings", r, out)
+}
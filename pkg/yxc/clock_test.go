@@ -0,0 +1,61 @@
+package yxc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/amannm/yxc/pkg/yxc"
+	"github.com/amannm/yxc/pkg/yxc/api"
+	"github.com/amannm/yxc/pkg/yxc/mock"
+)
+
+// TestAlarmSettingsRoundTrip checks that the generated AlarmSettings sends
+// false and 0 when they are set, leaves out what is not, and that the mock
+// device ends up with alarm_on false.
+func TestAlarmSettingsRoundTrip(t *testing.T) {
+	off, zero := false, 0
+	mode := api.AlarmSettingsModeOneday
+	in := yxc.AlarmSettings{AlarmOn: &off, Volume: &zero, Mode: &mode}
+	body, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"alarm_on":false,"mode":"oneday","volume":0}`; string(body) != want {
+		t.Errorf("encoded %s, want %s", body, want)
+	}
+	var out yxc.AlarmSettings
+	if err := json.Unmarshal(body, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("decoded %+v, want %+v", out, in)
+	}
+
+	d := mock.New()
+	defer d.Close()
+	srv := httptest.NewServer(d)
+	defer srv.Close()
+	ctx := context.Background()
+	k := yxc.New(yxc.Config{BaseURL: srv.URL + "/YamahaExtendedControl"}).Clock()
+	on := true
+	for _, want := range []bool{true, false} {
+		if want {
+			in.AlarmOn = &on
+		} else {
+			in.AlarmOn = &off
+		}
+		if _, err := k.SetAlarmSettings(ctx, in); err != nil {
+			t.Fatal(err)
+		}
+		settings, err := k.GetSettings(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if settings.Alarm.AlarmOn != want || settings.Alarm.Volume != 0 {
+			t.Errorf("alarm_on %v volume %d, want %v 0", settings.Alarm.AlarmOn, settings.Alarm.Volume, want)
+		}
+	}
+}
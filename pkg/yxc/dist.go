@@ -0,0 +1,82 @@
+package yxc
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/amannm/yxc/pkg/yxc/api"
+)
+
+type DistService struct {
+	c *Client
+}
+
+func (c *Client) Dist() *DistService {
+	return &DistService{c: c}
+}
+
+type DistributionClient struct {
+	IPAddress string `json:"ip_address"`
+	DataType  string `json:"data_type"`
+}
+
+type DistributionInfo struct {
+	Response
+	GroupID      string               `json:"group_id"`
+	GroupName    string               `json:"group_name"`
+	Role         string               `json:"role"`
+	Status       string               `json:"status"`
+	ServerZone   string               `json:"server_zone"`
+	ClientList   []DistributionClient `json:"client_list"`
+	BuildDisable []struct {
+		Role    string   `json:"role"`
+		Reasons []string `json:"reasons"`
+	} `json:"build_disable"`
+	AudioDropout bool `json:"audio_dropout"`
+}
+
+type ServerInfoRequest struct {
+	GroupID    string   `json:"group_id"`
+	Zone       string   `json:"zone,omitempty"`
+	Type       string   `json:"type,omitempty"`
+	ClientList []string `json:"client_list,omitempty"`
+}
+
+type ClientInfoRequest struct {
+	GroupID         string   `json:"group_id"`
+	Zone            []string `json:"zone,omitempty"`
+	ServerIPAddress string   `json:"server_ip_address,omitempty"`
+}
+
+// GroupNameRequest is the body of setGroupName, generated from the spec.
+type GroupNameRequest = api.SetGroupNameRequest
+
+func (d *DistService) GetDistributionInfo(ctx context.Context) (*DistributionInfo, error) {
+	out := &DistributionInfo{}
+	return out, d.c.get(ctx, "dist/getDistributionInfo", nil, out)
+}
+
+func (d *DistService) SetServerInfo(ctx context.Context, r ServerInfoRequest) (*Response, error) {
+	out := &Response{}
+	return out, d.c.post(ctx, "dist/setServerInfo", r, out)
+}
+
+func (d *DistService) SetClientInfo(ctx context.Context, r ClientInfoRequest) (*Response, error) {
+	out := &Response{}
+	return out, d.c.post(ctx, "dist/setClientInfo", r, out)
+}
+
+func (d *DistService) StartDistribution(ctx context.Context, num int) (*Response, error) {
+	out := &Response{}
+	return out, d.c.get(ctx, "dist/startDistribution", setQuery("num", strconv.Itoa(num)), out)
+}
+
+func (d *DistService) StopDistribution(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, d.c.get(ctx, "dist/stopDistribution", nil, out)
+}
+
+func (d *DistService) SetGroupName(ctx context.Context, r GroupNameRequest) (*Response, error) {
+	out := &Response{}
+	return out, d.c.post(ctx, "dist/setGroupName", r, out)
+}
@@ -0,0 +1,123 @@
+package yxc
+
+import (
+	"errors"
+	"fmt"
+)
+
+type HTTPError struct {
+	Endpoint string
+	Status   int
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("http %d", e.Status)
+}
+
+// Response code classes. A *ResponseError unwraps to exactly one of these,
+// so callers can branch with errors.Is without listing individual codes.
+var (
+	ErrInitializing     = errors.New("initializing")
+	ErrInternal         = errors.New("internal error")
+	ErrInvalidRequest   = errors.New("invalid request")
+	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrGuarded          = errors.New("guarded")
+	ErrTimeout          = errors.New("time out")
+	ErrFirmwareUpdating = errors.New("firmware updating")
+	ErrStreamingService = errors.New("streaming service error")
+	ErrDistribution     = errors.New("distribution error")
+	ErrUnknownResponse  = errors.New("unknown response code")
+)
+
+type responseCodeInfo struct {
+	meaning     string
+	explanation string
+}
+
+// responseCodes is the response code list from the API specification.
+var responseCodes = map[int]responseCodeInfo{
+	0:   {"Successful request", ""},
+	1:   {"Initializing", "the device is still starting up; try again shortly"},
+	2:   {"Internal Error", "the device failed to process the request"},
+	3:   {"Invalid Request", "the method does not exist or is not supported by this device or zone"},
+	4:   {"Invalid Parameter", "a parameter is out of range, has invalid characters or is missing"},
+	5:   {"Guarded", "the setting cannot be changed in the current state (for example the zone is off or the input does not allow it)"},
+	6:   {"Time Out", "the device timed out handling the request; try again"},
+	99:  {"Firmware Updating", "the device is updating its firmware; wait until it finishes"},
+	100: {"Access Error", "the streaming service could not be reached"},
+	101: {"Other Errors", "the streaming service reported an error"},
+	102: {"Wrong User Name", "the streaming service rejected the user name"},
+	103: {"Wrong Password", "the streaming service rejected the password"},
+	104: {"Account Expired", "the streaming service account has expired"},
+	105: {"Account Disconnected/Gone Off/Shut Down", "the streaming service account is disconnected or the service has shut down"},
+	106: {"Account Number Reached to the Limit", "the streaming service account limit has been reached"},
+	107: {"Server Maintenance", "the streaming service is under maintenance"},
+	108: {"Invalid Account", "the streaming service account is invalid"},
+	109: {"License Error", "the streaming service license is not valid"},
+	110: {"Read Only Mode", "the streaming service is in read only mode"},
+	111: {"Max Stations", "the streaming service station limit has been reached"},
+	112: {"Access Denied", "the streaming service denied access"},
+	113: {"There is a need to specify the additional destination Playlist", "specify the playlist to add to"},
+	114: {"There is a need to create a new Playlist", "create a playlist first"},
+	115: {"Simultaneous logins has reached the upper limit", "too many devices are logged in to the streaming service account"},
+	200: {"Linking in progress", "the device is joining a link group; try again when it completes"},
+	201: {"Unlinking in progress", "the device is leaving a link group; try again when it completes"},
+}
+
+// ResponseCodeMeaning returns the specification's name for a response code.
+func ResponseCodeMeaning(code int) string {
+	if info, ok := responseCodes[code]; ok {
+		return info.meaning
+	}
+	return "Unknown"
+}
+
+// ResponseError is a non-zero response_code returned by the device.
+type ResponseError struct {
+	Endpoint string
+	Code     int
+}
+
+func (e *ResponseError) Error() string {
+	if e.Endpoint == "" {
+		return fmt.Sprintf("response_code %d (%s)", e.Code, e.Meaning())
+	}
+	return fmt.Sprintf("%s: response_code %d (%s)", e.Endpoint, e.Code, e.Meaning())
+}
+
+// Meaning is the specification's name for the code, e.g. "Guarded".
+func (e *ResponseError) Meaning() string {
+	return ResponseCodeMeaning(e.Code)
+}
+
+// Explanation is a longer, human-readable hint about what the code usually
+// means in practice. It is empty for codes the specification does not list.
+func (e *ResponseError) Explanation() string {
+	return responseCodes[e.Code].explanation
+}
+
+// Unwrap returns the class sentinel for the code, e.g. ErrGuarded.
+func (e *ResponseError) Unwrap() error {
+	switch {
+	case e.Code == 1:
+		return ErrInitializing
+	case e.Code == 2:
+		return ErrInternal
+	case e.Code == 3:
+		return ErrInvalidRequest
+	case e.Code == 4:
+		return ErrInvalidParameter
+	case e.Code == 5:
+		return ErrGuarded
+	case e.Code == 6:
+		return ErrTimeout
+	case e.Code == 99:
+		return ErrFirmwareUpdating
+	case e.Code >= 100 && e.Code <= 199:
+		return ErrStreamingService
+	case e.Code >= 200 && e.Code <= 299:
+		return ErrDistribution
+	default:
+		return ErrUnknownResponse
+	}
+}
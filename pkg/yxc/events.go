@@ -0,0 +1,192 @@
+package yxc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"strconv"
+	"time"
+)
+
+// EventTimeout is how long a device keeps pushing events after the last
+// request that carried X-AppName and X-AppPort.
+const EventTimeout = 10 * time.Minute
+
+// Event is one UDP notification. Sections holds each top-level object
+// (system, main, zone2, tuner, netusb, cd, dist, clock) keyed by name.
+type Event struct {
+	DeviceID string
+	From     *net.UDPAddr
+	Received time.Time
+	Sections map[string]map[string]json.RawMessage
+	raw      []byte
+}
+
+func (e *Event) Raw() []byte {
+	return e.raw
+}
+
+func (e *Event) Has(section, key string) bool {
+	_, ok := e.Sections[section][key]
+	return ok
+}
+
+func (e *Event) Flag(section, key string) bool {
+	var v bool
+	raw, ok := e.Sections[section][key]
+	return ok && json.Unmarshal(raw, &v) == nil && v
+}
+
+func DecodeEvent(b []byte) (*Event, error) {
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(b, &top); err != nil {
+		return nil, err
+	}
+	e := &Event{Sections: map[string]map[string]json.RawMessage{}, raw: b}
+	for k, v := range top {
+		if k == "device_id" {
+			_ = json.Unmarshal(v, &e.DeviceID)
+			continue
+		}
+		var section map[string]json.RawMessage
+		if json.Unmarshal(v, &section) == nil && section != nil {
+			e.Sections[k] = section
+		}
+	}
+	return e, nil
+}
+
+type EventListener struct {
+	conn *net.UDPConn
+}
+
+// ListenEvents binds a UDP socket for event delivery. An empty addr or
+// port 0 picks an ephemeral port.
+func ListenEvents(addr string) (*EventListener, error) {
+	if addr == "" {
+		addr = ":0"
+	}
+	udp, err := net.ResolveUDPAddr("udp4", addr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.ListenUDP("udp4", udp)
+	if err != nil {
+		return nil, err
+	}
+	return &EventListener{conn: conn}, nil
+}
+
+func (l *EventListener) Port() int {
+	return l.conn.LocalAddr().(*net.UDPAddr).Port
+}
+
+func (l *EventListener) Close() error {
+	return l.conn.Close()
+}
+
+// Next blocks until an event arrives or ctx is done. Datagrams that are not
+// JSON objects are skipped.
+func (l *EventListener) Next(ctx context.Context) (*Event, error) {
+	stop := context.AfterFunc(ctx, func() {
+		_ = l.conn.SetReadDeadline(time.Now())
+	})
+	defer stop()
+	defer l.conn.SetReadDeadline(time.Time{})
+	buf := make([]byte, 65536)
+	for {
+		n, from, err := l.conn.ReadFromUDP(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, err
+		}
+		e, err := DecodeEvent(append([]byte(nil), buf[:n]...))
+		if err != nil {
+			continue
+		}
+		e.From = from
+		e.Received = time.Now()
+		return e, nil
+	}
+}
+
+// Subscribe sends a getDeviceInfo request carrying the event headers so the
+// device starts, or keeps, pushing events to port.
+func (c *Client) Subscribe(ctx context.Context, appName string, port int) error {
+	sub := *c
+	sub.Config.Headers = append(append([]string{}, c.Config.Headers...),
+		"X-AppName: "+appName,
+		"X-AppPort: "+strconv.Itoa(port),
+	)
+	_, err := sub.System().GetDeviceInfo(ctx)
+	return err
+}
+
+// Watch subscribes, renews the subscription every renew interval (half of
+// EventTimeout when zero) and passes each event to handle until ctx is done
+// or handle returns an error.
+func (c *Client) Watch(ctx context.Context, l *EventListener, appName string, renew time.Duration, handle func(*Event) error) error {
+	renew, err := renewInterval(renew)
+	if err != nil {
+		return err
+	}
+	if err := c.Subscribe(ctx, appName, l.Port()); err != nil {
+		return err
+	}
+	return c.watch(ctx, l, appName, renew, handle)
+}
+
+// renewInterval defaults renew to half of EventTimeout and checks that it
+// renews the subscription before it expires.
+func renewInterval(renew time.Duration) (time.Duration, error) {
+	if renew <= 0 {
+		return EventTimeout / 2, nil
+	}
+	if renew >= EventTimeout {
+		return 0, errors.New("events: renew interval must be shorter than the 10 minute expiry")
+	}
+	return renew, nil
+}
+
+// watch is Watch for a subscription that is already in place.
+func (c *Client) watch(ctx context.Context, l *EventListener, appName string, renew time.Duration, handle func(*Event) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events := make(chan *Event)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			e, err := l.Next(ctx)
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case events <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	ticker := time.NewTicker(renew)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errs:
+			return err
+		case <-ticker.C:
+			if err := c.Subscribe(ctx, appName, l.Port()); err != nil {
+				return err
+			}
+		case e := <-events:
+			if err := handle(e); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package yxc_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/amannm/yxc/pkg/yxc"
+	"github.com/amannm/yxc/pkg/yxc/mock"
+)
+
+func TestDecodeEvent(t *testing.T) {
+	// Captured from an RX-V6A while the volume was turned up during Net
+	// Radio playback.
+	payload := []byte(`{"main":{"volume":52,"actual_volume":{"mode":"db","value":-54.5,"unit":"dB"},"status_updated":true},` +
+		`"netusb":{"play_time":-60000,"play_info_updated":false},"system":{"name_text_updated":true},` +
+		`"device_id":"AC44F2853B12","version":1}`)
+	e, err := yxc.DecodeEvent(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.DeviceID != "AC44F2853B12" {
+		t.Errorf("device_id = %q", e.DeviceID)
+	}
+	if len(e.Sections) != 3 {
+		t.Errorf("sections = %v, want main, netusb and system", e.Sections)
+	}
+	if string(e.Sections["main"]["volume"]) != "52" || string(e.Raw()) != string(payload) {
+		t.Errorf("main.volume = %s, raw = %s", e.Sections["main"]["volume"], e.Raw())
+	}
+	for _, tc := range []struct {
+		section, key string
+		has, flag    bool
+	}{
+		{"main", "status_updated", true, true},
+		{"main", "volume", true, false},
+		{"netusb", "play_info_updated", true, false},
+		{"system", "name_text_updated", true, true},
+		{"zone2", "status_updated", false, false},
+		{"device_id", "", false, false},
+	} {
+		if has, flag := e.Has(tc.section, tc.key), e.Flag(tc.section, tc.key); has != tc.has || flag != tc.flag {
+			t.Errorf("%s.%s: Has %v Flag %v, want %v %v", tc.section, tc.key, has, flag, tc.has, tc.flag)
+		}
+	}
+	if _, err := yxc.DecodeEvent([]byte("M-SEARCH * HTTP/1.1")); err == nil {
+		t.Error("decoded a datagram that is not JSON")
+	}
+}
+
+// TestWatchRenews checks that Watch re-sends the event headers every renew
+// interval, keeps delivering events across renewals, and refuses an interval
+// that would let the subscription lapse.
+func TestWatchRenews(t *testing.T) {
+	d := mock.New()
+	defer d.Close()
+	var subscribes atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-AppName") == "yxc-test" && r.Header.Get("X-AppPort") != "" {
+			subscribes.Add(1)
+		}
+		d.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+	c := yxc.New(yxc.Config{BaseURL: srv.URL + "/YamahaExtendedControl"})
+	l, err := yxc.ListenEvents("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := c.Watch(ctx, l, "yxc-test", yxc.EventTimeout, nil); err == nil || subscribes.Load() != 0 {
+		t.Fatalf("renew of %v: err %v after %d subscriptions", yxc.EventTimeout, err, subscribes.Load())
+	}
+
+	go func() {
+		for subscribes.Load() < 3 && ctx.Err() == nil {
+			time.Sleep(10 * time.Millisecond)
+		}
+		d.Notify("main", map[string]any{"volume": 60, "status_updated": true})
+	}()
+	var got *yxc.Event
+	err = c.Watch(ctx, l, "yxc-test", 20*time.Millisecond, func(e *yxc.Event) error {
+		got = e
+		return errors.New("done")
+	})
+	if err == nil || err.Error() != "done" {
+		t.Fatalf("watch: %v", err)
+	}
+	if n := subscribes.Load(); n < 3 {
+		t.Errorf("subscribed %d times, want the first subscription and at least two renewals", n)
+	}
+	if got.DeviceID != d.DeviceID() || !got.Flag("main", "status_updated") {
+		t.Errorf("event after renewals: %s", got.Raw())
+	}
+}
@@ -0,0 +1,260 @@
+package yxc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type RawResponse struct {
+	Request *http.Request
+	Status  int
+	Header  http.Header
+	Body    []byte
+}
+
+func (c *Client) API(path string) string {
+	prefix := strings.TrimSpace(c.Config.APIPrefix)
+	if prefix == "" {
+		prefix = "/v1"
+	}
+	if !strings.HasPrefix(prefix, "/") {
+		prefix = "/" + prefix
+	}
+	prefix = strings.TrimRight(prefix, "/")
+	path = strings.TrimLeft(path, "/")
+	return prefix + "/" + path
+}
+
+func (c *Client) baseURL() (string, error) {
+	if strings.TrimSpace(c.Config.BaseURL) != "" {
+		return strings.TrimRight(c.Config.BaseURL, "/"), nil
+	}
+	host := strings.TrimSpace(c.Config.Host)
+	if host == "" {
+		return "", errors.New("host or base-url is required")
+	}
+	if strings.HasPrefix(host, "http://") || strings.HasPrefix(host, "https://") {
+		return strings.TrimRight(host, "/") + "/YamahaExtendedControl", nil
+	}
+	return "http://" + host + "/YamahaExtendedControl", nil
+}
+
+// Do sends a request to path and returns the raw exchange without
+// interpreting the HTTP status or response_code. When CheckResponse rejects
+// the response, both are returned.
+func (c *Client) Do(ctx context.Context, method, path string, q url.Values, body []byte, contentType string) (*RawResponse, error) {
+	if c.DryRun != nil || c.Validate != nil {
+		req, err := c.BuildRequest(ctx, method, path, q, body, contentType)
+		if err != nil {
+			return nil, err
+		}
+		if c.Validate != nil {
+			if err := c.Validate(req, body); err != nil {
+				return nil, err
+			}
+		}
+		if c.DryRun != nil {
+			return &RawResponse{Request: req}, c.DryRun(req, body)
+		}
+	}
+	resp, err := c.doRequest(ctx, method, path, q, body, contentType)
+	if err != nil {
+		return nil, err
+	}
+	if c.Trace != nil {
+		c.Trace(resp.Request, resp.Status)
+	}
+	if c.CheckResponse != nil && resp.Status < 300 {
+		if err := c.CheckResponse(resp); err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+func (c *Client) BuildRequest(ctx context.Context, method, path string, q url.Values, body []byte, contentType string) (*http.Request, error) {
+	u, err := c.buildURL(path, q)
+	if err != nil {
+		return nil, err
+	}
+	var reader io.Reader
+	if len(body) > 0 {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" && len(body) > 0 {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for _, h := range c.Config.Headers {
+		k, v, ok := splitHeader(h)
+		if ok {
+			req.Header.Add(k, v)
+		}
+	}
+	if strings.TrimSpace(c.Config.Auth) != "" && req.Header.Get("Authorization") == "" {
+		user, pass := splitAuth(c.Config.Auth)
+		req.SetBasicAuth(user, pass)
+	}
+	return req, nil
+}
+
+func (c *Client) buildURL(path string, q url.Values) (string, error) {
+	base := ""
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		base = path
+	} else {
+		root, err := c.baseURL()
+		if err != nil {
+			return "", err
+		}
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		base = root + path
+	}
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	if q != nil && len(q) > 0 {
+		existing := u.Query()
+		for k, vals := range q {
+			for _, v := range vals {
+				existing.Add(k, v)
+			}
+		}
+		u.RawQuery = existing.Encode()
+	}
+	return u.String(), nil
+}
+
+func (c *Client) doRequest(ctx context.Context, method, path string, q url.Values, body []byte, contentType string) (*RawResponse, error) {
+	policy := c.Config.Retry
+	retryable := policy.RetryWrites || idempotent(method, path)
+	retries := 0
+	var firmwareDeadline time.Time
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, method, path, q, body, contentType)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		reason := retryReason(path, resp, err)
+		if reason == nil || !retryable {
+			return resp, err
+		}
+		if errors.Is(reason, ErrFirmwareUpdating) {
+			if policy.FirmwareWait <= 0 {
+				return resp, nil
+			}
+			if firmwareDeadline.IsZero() {
+				firmwareDeadline = time.Now().Add(policy.FirmwareWait)
+			} else if time.Now().After(firmwareDeadline) {
+				return nil, fmt.Errorf("%w after %s: %w", ErrFirmwareWaitExpired, policy.FirmwareWait, reason)
+			}
+		} else {
+			if retries >= c.Config.Retries {
+				return resp, err
+			}
+			retries++
+		}
+		delay := policy.backoff(attempt)
+		if c.OnRetry != nil {
+			c.OnRetry(method, path, attempt+1, reason, delay)
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// send makes a single attempt once the host queue admits it. Config.Timeout
+// bounds the exchange itself, not the time spent queued.
+func (c *Client) send(ctx context.Context, method, path string, q url.Values, body []byte, contentType string) (*RawResponse, error) {
+	req, err := c.BuildRequest(ctx, method, path, q, body, contentType)
+	if err != nil {
+		return nil, err
+	}
+	if c.Config.Throttle != (Throttle{}) {
+		release, err := HostQueueFor(req.URL.Host, c.Config.Throttle).Acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+	if c.Config.Timeout > 0 {
+		tctx, cancel := context.WithTimeout(ctx, c.Config.Timeout)
+		defer cancel()
+		req = req.WithContext(tctx)
+	}
+	hc, err := c.httpClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &RawResponse{Request: req, Status: resp.StatusCode, Header: resp.Header, Body: respBody}, nil
+}
+
+func ResponseCode(body []byte) (int, bool) {
+	if len(body) == 0 {
+		return 0, false
+	}
+	var v map[string]any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return 0, false
+	}
+	val, ok := v["response_code"]
+	if !ok {
+		return 0, false
+	}
+	switch t := val.(type) {
+	case float64:
+		return int(t), true
+	case int:
+		return t, true
+	case string:
+		if n, err := strconv.Atoi(t); err == nil {
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+func splitHeader(h string) (string, string, bool) {
+	if strings.Contains(h, ":") {
+		parts := strings.SplitN(h, ":", 2)
+		return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), parts[0] != ""
+	}
+	if strings.Contains(h, "=") {
+		parts := strings.SplitN(h, "=", 2)
+		return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), parts[0] != ""
+	}
+	return "", "", false
+}
+
+func splitAuth(auth string) (string, string) {
+	parts := strings.SplitN(auth, ":", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
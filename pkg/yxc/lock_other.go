@@ -0,0 +1,22 @@
+//go:build !unix
+
+package yxc
+
+import (
+	"context"
+	"time"
+)
+
+// fileLock is a no-op where flock(2) is unavailable; only the in-process
+// queue applies.
+type fileLock struct {
+	path string
+}
+
+func (l *fileLock) acquire(ctx context.Context) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func (l *fileLock) stamp(t time.Time) {}
+
+func (l *fileLock) release() {}
@@ -0,0 +1,75 @@
+//go:build unix
+
+package yxc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const lockPollInterval = 20 * time.Millisecond
+
+// fileLock is an flock(2) lock whose file holds the start time of the last
+// request, in Unix nanoseconds, so the minimum gap carries across processes.
+// A lock file that cannot be opened is skipped rather than failing requests.
+type fileLock struct {
+	path string
+	f    *os.File
+}
+
+func (l *fileLock) acquire(ctx context.Context) (time.Time, error) {
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o755); err != nil {
+		return time.Time{}, nil
+	}
+	f, err := os.OpenFile(l.path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return time.Time{}, nil
+	}
+	for {
+		err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, syscall.EWOULDBLOCK) && !errors.Is(err, syscall.EINTR) {
+			_ = f.Close()
+			return time.Time{}, nil
+		}
+		if err := sleep(ctx, lockPollInterval); err != nil {
+			_ = f.Close()
+			return time.Time{}, err
+		}
+	}
+	l.f = f
+	data, _ := io.ReadAll(io.LimitReader(f, 64))
+	n, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return time.Time{}, nil
+	}
+	return time.Unix(0, n), nil
+}
+
+func (l *fileLock) stamp(t time.Time) {
+	if l.f == nil {
+		return
+	}
+	if err := l.f.Truncate(0); err != nil {
+		return
+	}
+	_, _ = l.f.WriteAt([]byte(strconv.FormatInt(t.UnixNano(), 10)), 0)
+}
+
+func (l *fileLock) release() {
+	if l.f == nil {
+		return
+	}
+	_ = syscall.Flock(int(l.f.Fd()), syscall.LOCK_UN)
+	_ = l.f.Close()
+	l.f = nil
+}
@@ -0,0 +1,1056 @@
+package mock
+
+import (
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// call carries one request through a handler. Parameter helpers record
+// response_code 3 for a missing parameter and 4 for an invalid one; once a
+// code is set the handler must not change state.
+type call struct {
+	d      *Device
+	zone   string
+	q      url.Values
+	body   map[string]any
+	code   int
+	resp   map[string]any
+	events map[string]map[string]any
+}
+
+type handler struct {
+	method string
+	fn     func(c *call)
+}
+
+func get(fn func(c *call)) handler {
+	return handler{method: http.MethodGet, fn: fn}
+}
+
+func post(fn func(c *call)) handler {
+	return handler{method: http.MethodPost, fn: fn}
+}
+
+func (c *call) fail(code int) {
+	if c.code == 0 {
+		c.code = code
+	}
+}
+
+func (c *call) ok() bool {
+	return c.code == 0
+}
+
+func (c *call) str(name string) string {
+	if !c.q.Has(name) {
+		c.fail(3)
+	}
+	return c.q.Get(name)
+}
+
+func (c *call) choice(name string, values ...string) string {
+	v := c.str(name)
+	if c.ok() && !slices.Contains(values, v) {
+		c.fail(4)
+	}
+	return v
+}
+
+func (c *call) num(name string, min, max int) int {
+	v := c.str(name)
+	if !c.ok() {
+		return 0
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < min || n > max {
+		c.fail(4)
+	}
+	return n
+}
+
+func (c *call) optNum(name string, min, max int) (int, bool) {
+	if !c.q.Has(name) {
+		return 0, false
+	}
+	return c.num(name, min, max), true
+}
+
+func (c *call) flag(name string) bool {
+	v := c.str(name)
+	if !c.ok() {
+		return false
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		c.fail(4)
+	}
+	return b
+}
+
+func (c *call) event(section, key string, v any) {
+	if c.events[section] == nil {
+		c.events[section] = map[string]any{}
+	}
+	c.events[section][key] = v
+}
+
+func (c *call) reply(fields map[string]any) {
+	for k, v := range fields {
+		c.resp[k] = v
+	}
+}
+
+func (c *call) bodyString(key string) string {
+	s, _ := c.body[key].(string)
+	return s
+}
+
+// set stores value under key in target and raises an event, unless an
+// earlier parameter check failed.
+func (c *call) set(target map[string]any, key string, value any, section, eventKey string, eventValue any) {
+	if !c.ok() {
+		return
+	}
+	target[key] = value
+	c.event(section, eventKey, eventValue)
+}
+
+func (c *call) zoneStatus() map[string]any {
+	return c.d.zones[c.zone]
+}
+
+// zoneFlag is a setter for a boolean zone field reported via status_updated.
+func zoneFlag(param, field string) handler {
+	return get(func(c *call) {
+		v := c.flag(param)
+		c.set(c.zoneStatus(), field, v, c.zone, "status_updated", true)
+	})
+}
+
+// zoneRange is a setter for an integer zone field bounded by range_step.
+func zoneRange(param, field string) handler {
+	return get(func(c *call) {
+		r := zoneRanges[field]
+		v := c.num(param, int(r.min), int(r.max))
+		c.set(c.zoneStatus(), field, v, c.zone, "status_updated", true)
+	})
+}
+
+func zoneChoice(param, field string, values ...string) handler {
+	return get(func(c *call) {
+		v := c.choice(param, values...)
+		c.set(c.zoneStatus(), field, v, c.zone, "status_updated", true)
+	})
+}
+
+func systemFlag(param, field string) handler {
+	return get(func(c *call) {
+		v := c.flag(param)
+		c.set(c.d.funcStatus, field, v, "system", "func_status_updated", true)
+	})
+}
+
+func noop(checks ...func(c *call)) handler {
+	return get(func(c *call) {
+		for _, check := range checks {
+			check(c)
+		}
+	})
+}
+
+func returns(fn func(d *Device) map[string]any) handler {
+	return get(func(c *call) {
+		c.reply(fn(c.d))
+	})
+}
+
+func (c *call) setVolume(volume int) {
+	if !c.ok() {
+		return
+	}
+	z := c.zoneStatus()
+	z["volume"] = volume
+	z["actual_volume"] = actualVolume(volume)
+	c.event(c.zone, "volume", volume)
+}
+
+func (c *call) selectInput(zone, input string) {
+	z := c.d.zones[zone]
+	if z == nil {
+		c.fail(4)
+		return
+	}
+	if !slices.Contains(c.d.zoneInputs(zone), input) {
+		c.fail(4)
+		return
+	}
+	z["input"] = input
+	z["input_text"] = c.d.nameText[input]
+	if z["power"] != "on" {
+		z["power"] = "on"
+		c.event(zone, "power", "on")
+	}
+	c.event(zone, "input", input)
+	switch playInfoType[input] {
+	case "netusb":
+		c.d.netusb["input"] = input
+		c.event("netusb", "play_info_updated", true)
+	case "tuner":
+		c.event("tuner", "play_info_updated", true)
+	case "cd":
+		c.event("cd", "play_info_updated", true)
+	}
+}
+
+var handlers = map[string]handler{}
+
+func init() {
+	zones := []string{"main", "zone2", "zone3", "zone4"}
+	bands := []string{"common", "am", "fm", "dab"}
+
+	// system
+	handlers["system/getDeviceInfo"] = returns(func(d *Device) map[string]any { return d.deviceInfo })
+	handlers["system/getFeatures"] = returns(func(d *Device) map[string]any { return d.features })
+	handlers["system/getNetworkStatus"] = returns(func(d *Device) map[string]any { return d.network })
+	handlers["system/setWiredLan"] = post(func(c *call) {
+		c.d.network["connection"] = "wired_lan"
+		if dhcp, ok := c.body["dhcp"].(bool); ok {
+			c.d.network["dhcp"] = dhcp
+		}
+		c.event("system", "func_status_updated", true)
+	})
+	handlers["system/setWirelessLan"] = post(func(c *call) {
+		if c.bodyString("ssid") == "" {
+			c.fail(3)
+			return
+		}
+		c.d.network["connection"] = "wireless_lan"
+		c.d.network["wireless_lan"] = map[string]any{
+			"ssid": c.bodyString("ssid"), "type": c.bodyString("type"), "key": "", "ch": 0, "signal_level": 80,
+		}
+	})
+	handlers["system/setWirelessDirect"] = post(func(c *call) {
+		c.d.network["connection"] = "wireless_direct"
+	})
+	handlers["system/setNetworkName"] = get(func(c *call) {
+		name := c.str("name")
+		c.set(c.d.network, "network_name", name, "system", "name_text_updated", true)
+	})
+	handlers["system/setAirPlayPin"] = noop(func(c *call) { c.str("pin") })
+	handlers["system/getNetworkStandby"] = returns(func(d *Device) map[string]any {
+		return map[string]any{"network_standby": d.netStandby}
+	})
+	handlers["system/setNetworkStandby"] = get(func(c *call) {
+		v := c.choice("standby", "off", "on", "auto")
+		if c.ok() {
+			c.d.netStandby = v
+		}
+	})
+	handlers["system/getBluetoothInfo"] = returns(func(d *Device) map[string]any { return d.bluetooth })
+	handlers["system/setBluetoothStandby"] = get(func(c *call) {
+		v := c.flag("enable")
+		c.set(c.d.bluetooth, "bluetooth_standby", v, "system", "bluetooth_info_updated", true)
+	})
+	handlers["system/setBluetoothTxSetting"] = get(func(c *call) {
+		v := c.flag("enable")
+		c.set(c.d.bluetooth, "bluetooth_tx_setting", v, "system", "bluetooth_info_updated", true)
+	})
+	handlers["system/getBluetoothDeviceList"] = returns(func(d *Device) map[string]any {
+		return map[string]any{"device_list": d.btDevices}
+	})
+	handlers["system/updateBluetoothDeviceList"] = noop()
+	handlers["system/connectBluetoothDevice"] = get(func(c *call) {
+		addr := c.str("address")
+		if !c.ok() {
+			return
+		}
+		for _, item := range c.d.btDevices {
+			dev := item.(map[string]any)
+			if dev["address"] == addr {
+				c.set(c.d.bluetooth, "bluetooth_device", map[string]any{
+					"connected": true, "name": dev["name"], "type": dev["type"], "address": addr,
+				}, "system", "bluetooth_info_updated", true)
+				return
+			}
+		}
+		c.fail(4)
+	})
+	handlers["system/disconnectBluetoothDevice"] = get(func(c *call) {
+		c.set(c.d.bluetooth, "bluetooth_device", map[string]any{
+			"connected": false, "name": "", "type": "unknown", "address": "",
+		}, "system", "bluetooth_info_updated", true)
+	})
+	handlers["system/getFuncStatus"] = returns(func(d *Device) map[string]any { return d.funcStatus })
+	handlers["system/setAutoPowerStandby"] = systemFlag("enable", "auto_power_standby")
+	handlers["system/setIrSensor"] = systemFlag("enable", "ir_sensor")
+	handlers["system/setSpeakerA"] = systemFlag("enable", "speaker_a")
+	handlers["system/setSpeakerB"] = systemFlag("enable", "speaker_b")
+	handlers["system/setDimmer"] = get(func(c *call) {
+		v := c.num("value", -1, 3)
+		c.set(c.d.funcStatus, "dimmer", v, "system", "func_status_updated", true)
+	})
+	handlers["system/setZoneBVolumeSync"] = systemFlag("enable", "zone_b_volume_sync")
+	handlers["system/setHdmiOut1"] = systemFlag("enable", "hdmi_out_1")
+	handlers["system/setHdmiOut2"] = systemFlag("enable", "hdmi_out_2")
+	handlers["system/getNameText"] = get(func(c *call) {
+		id := c.str("id")
+		if !c.ok() {
+			return
+		}
+		text, ok := c.d.nameText[id]
+		if !ok {
+			c.fail(4)
+			return
+		}
+		c.reply(map[string]any{"id": id, "text": text})
+	})
+	handlers["system/setNameText"] = get(func(c *call) {
+		id := c.str("id")
+		text := c.str("text")
+		if !c.ok() {
+			return
+		}
+		if _, ok := c.d.nameText[id]; !ok {
+			c.fail(4)
+			return
+		}
+		c.d.nameText[id] = text
+		c.event("system", "name_text_updated", true)
+	})
+	handlers["system/getLocationInfo"] = returns(func(d *Device) map[string]any { return d.location })
+	handlers["system/sendIrCode"] = noop(func(c *call) {
+		code := c.str("code")
+		if c.ok() && len(code) != 8 {
+			c.fail(4)
+		}
+	})
+	handlers["system/setAutoPlay"] = systemFlag("enable", "auto_play")
+	handlers["system/setSpeakerPattern"] = get(func(c *call) {
+		v := c.num("num", 1, 4)
+		c.set(c.d.funcStatus, "speaker_pattern", v, "system", "func_status_updated", true)
+	})
+	handlers["system/setPartyMode"] = systemFlag("enable", "party_mode")
+	handlers["system/getRemoteInfo"] = get(func(c *call) {
+		info := map[string]any{
+			"ip_address":      c.d.network["ip_address"],
+			"mac_address":     c.d.network["mac_address"],
+			"network_standby": c.d.netStandby,
+		}
+		for _, k := range []string{"model_name", "destination", "system_version", "api_version",
+			"netmodule_version", "netmodule_checksum", "serial_number"} {
+			info[k] = c.d.deviceInfo[k]
+		}
+		for zone, z := range c.d.zones {
+			info[zone] = map[string]any{"power": z["power"], "input": z["input"], "volume": z["volume"], "mute": z["mute"]}
+		}
+		c.reply(info)
+	})
+	handlers["system/requestNetworkReboot"] = noop()
+	handlers["system/requestSystemReboot"] = noop()
+
+	// zone
+	handlers["zone/getStatus"] = get(func(c *call) { c.reply(c.zoneStatus()) })
+	handlers["zone/getSoundProgramList"] = get(func(c *call) {
+		list := []any{}
+		if _, ok := c.zoneStatus()["sound_program"]; ok {
+			list = toAny(programs)
+		}
+		c.reply(map[string]any{"sound_program_list": list})
+	})
+	handlers["zone/setPower"] = get(func(c *call) {
+		v := c.choice("power", "on", "standby", "toggle")
+		if v == "toggle" {
+			v = "on"
+			if c.zoneStatus()["power"] == "on" {
+				v = "standby"
+			}
+		}
+		c.set(c.zoneStatus(), "power", v, c.zone, "power", v)
+	})
+	handlers["zone/setSleep"] = get(func(c *call) {
+		v := c.choice("sleep", "0", "30", "60", "90", "120")
+		n, _ := strconv.Atoi(v)
+		c.set(c.zoneStatus(), "sleep", n, c.zone, "status_updated", true)
+	})
+	handlers["zone/setVolume"] = get(func(c *call) {
+		v := c.str("volume")
+		if !c.ok() {
+			return
+		}
+		cur := c.zoneStatus()["volume"].(int)
+		switch v {
+		case "up", "down":
+			step := 1
+			if s, ok := c.optNum("step", 1, maxVolume); ok {
+				step = s
+			}
+			if v == "down" {
+				step = -step
+			}
+			c.setVolume(max(0, min(maxVolume, cur+step)))
+		default:
+			c.setVolume(c.num("volume", 0, maxVolume))
+		}
+	})
+	handlers["zone/setMute"] = get(func(c *call) {
+		v := c.flag("enable")
+		c.set(c.zoneStatus(), "mute", v, c.zone, "mute", v)
+	})
+	handlers["zone/setInput"] = get(func(c *call) {
+		input := c.str("input")
+		if c.q.Has("mode") {
+			c.choice("mode", "autoplay_disabled")
+		}
+		if c.ok() {
+			c.selectInput(c.zone, input)
+		}
+	})
+	handlers["zone/setSoundProgram"] = get(func(c *call) {
+		v := c.str("program")
+		if _, ok := c.zoneStatus()["sound_program"]; c.ok() && (!ok || !slices.Contains(programs, v)) {
+			c.fail(4)
+		}
+		c.set(c.zoneStatus(), "sound_program", v, c.zone, "status_updated", true)
+	})
+	handlers["zone/set3dSurround"] = zoneFlag("enable", "surround_3d")
+	handlers["zone/setDirect"] = zoneFlag("enable", "direct")
+	handlers["zone/setPureDirect"] = zoneFlag("enable", "pure_direct")
+	handlers["zone/setEnhancer"] = zoneFlag("enable", "enhancer")
+	handlers["zone/setToneControl"] = get(func(c *call) {
+		r := zoneRanges["tone_control"]
+		tone, _ := c.zoneStatus()["tone_control"].(map[string]any)
+		if tone == nil {
+			c.fail(3)
+			return
+		}
+		next := map[string]any{"mode": tone["mode"], "bass": tone["bass"], "treble": tone["treble"]}
+		if c.q.Has("mode") {
+			next["mode"] = c.choice("mode", "manual", "auto", "bypass")
+		}
+		for _, k := range []string{"bass", "treble"} {
+			if v, ok := c.optNum(k, int(r.min), int(r.max)); ok {
+				next[k] = v
+			}
+		}
+		c.set(c.zoneStatus(), "tone_control", next, c.zone, "status_updated", true)
+	})
+	handlers["zone/setEqualizer"] = get(func(c *call) {
+		r := zoneRanges["equalizer"]
+		eq, _ := c.zoneStatus()["equalizer"].(map[string]any)
+		if eq == nil {
+			c.fail(3)
+			return
+		}
+		next := map[string]any{"mode": eq["mode"], "low": eq["low"], "mid": eq["mid"], "high": eq["high"]}
+		if c.q.Has("mode") {
+			next["mode"] = c.choice("mode", "manual", "auto", "bypass")
+		}
+		for _, k := range []string{"low", "mid", "high"} {
+			if v, ok := c.optNum(k, int(r.min), int(r.max)); ok {
+				next[k] = v
+			}
+		}
+		c.set(c.zoneStatus(), "equalizer", next, c.zone, "status_updated", true)
+	})
+	handlers["zone/setBalance"] = zoneRange("value", "balance")
+	handlers["zone/setDialogueLevel"] = zoneRange("value", "dialogue_level")
+	handlers["zone/setDialogueLift"] = zoneRange("value", "dialogue_lift")
+	handlers["zone/setClearVoice"] = zoneFlag("enable", "clear_voice")
+	handlers["zone/setSubwooferVolume"] = zoneRange("volume", "subwoofer_volume")
+	handlers["zone/setBassExtension"] = zoneFlag("enable", "bass_extension")
+	handlers["zone/getSignalInfo"] = get(func(c *call) {
+		c.reply(map[string]any{"audio": map[string]any{"error": 0, "format": "PCM", "fs": "48 kHz"}})
+	})
+	handlers["zone/prepareInputChange"] = noop(func(c *call) {
+		if in := c.str("input"); c.ok() && !slices.Contains(c.d.zoneInputs(c.zone), in) {
+			c.fail(4)
+		}
+	})
+	handlers["zone/recallScene"] = get(func(c *call) {
+		n := c.num("num", 1, 8)
+		if c.ok() {
+			c.selectInput(c.zone, c.d.zoneInputs(c.zone)[(n-1)%len(c.d.zoneInputs(c.zone))])
+		}
+	})
+	handlers["zone/setContentsDisplay"] = noop(func(c *call) { c.flag("enable") })
+	handlers["zone/controlCursor"] = noop(func(c *call) {
+		c.choice("cursor", "up", "down", "left", "right", "select", "return")
+	})
+	handlers["zone/executeMenu"] = noop(func(c *call) {
+		c.choice("menu", "on_screen", "top_menu", "menu", "option", "display", "help", "home", "mode", "red", "green", "yellow", "blue")
+	})
+	handlers["zone/setActualVolume"] = get(func(c *call) {
+		mode := c.choice("mode", "db", "numeric")
+		raw := c.str("value")
+		if !c.ok() {
+			return
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			c.fail(4)
+			return
+		}
+		if mode == "db" {
+			value = (value - minDB) / dbStep
+		} else {
+			value = value / dbStep
+		}
+		if value != math.Trunc(value) || value < 0 || value > maxVolume {
+			c.fail(4)
+			return
+		}
+		c.setVolume(int(value))
+	})
+	handlers["zone/setSurroundDecoderType"] = get(func(c *call) {
+		v := c.choice("type", "toggle", "auto", "dolby_pl", "dolby_pl2x_movie", "dolby_pl2x_music",
+			"dolby_pl2x_game", "dolby_surround", "dts_neural_x", "dts_neo6_cinema", "dts_neo6_music")
+		if v == "toggle" {
+			v = "auto"
+			if c.zoneStatus()["surr_decoder_type"] == "auto" {
+				v = "dolby_surround"
+			}
+		}
+		c.set(c.zoneStatus(), "surr_decoder_type", v, c.zone, "status_updated", true)
+	})
+	handlers["zone/setLinkControl"] = zoneChoice("control", "link_control", "standard", "stability", "speed")
+	handlers["zone/setLinkAudioDelay"] = zoneChoice("delay", "link_audio_delay",
+		"lip_sync", "audio_sync", "audio_sync_on", "audio_sync_off", "balanced")
+	handlers["zone/setLinkAudioQuality"] = zoneChoice("quality", "link_audio_quality", "compressed", "uncompressed")
+
+	// tuner
+	handlers["tuner/getPresetInfo"] = get(func(c *call) {
+		band := c.choice("band", bands...)
+		if !c.ok() {
+			return
+		}
+		list := c.d.tunerPreset["common"]
+		if band != "common" {
+			filtered := []any{}
+			for _, p := range list {
+				if p.(map[string]any)["band"] == band {
+					filtered = append(filtered, p)
+				}
+			}
+			list = filtered
+		}
+		c.reply(map[string]any{"preset_info": list, "func_list": []any{"clear", "move"}})
+	})
+	handlers["tuner/getPlayInfo"] = returns(func(d *Device) map[string]any { return d.tuner })
+	handlers["tuner/setBand"] = get(func(c *call) {
+		band := c.choice("band", "am", "fm", "dab")
+		c.set(c.d.tuner, "band", band, "tuner", "play_info_updated", true)
+	})
+	handlers["tuner/setFreq"] = get(func(c *call) {
+		band := c.choice("band", "am", "fm")
+		tuning := c.choice("tuning", "tp_up", "tp_down", "direct", "auto_up", "auto_down", "cancel")
+		if !c.ok() {
+			return
+		}
+		r := map[string]rangeStep{"am": {531, 1611, 9}, "fm": {87500, 108000, 50}}[band]
+		info := c.d.tuner[band].(map[string]any)
+		freq := info["freq"].(int)
+		switch tuning {
+		case "direct":
+			freq = c.num("num", int(r.min), int(r.max))
+			if c.ok() && (freq-int(r.min))%int(r.step) != 0 {
+				c.fail(4)
+			}
+		case "tp_up", "auto_up":
+			freq = min(int(r.max), freq+int(r.step))
+		case "tp_down", "auto_down":
+			freq = max(int(r.min), freq-int(r.step))
+		}
+		if !c.ok() {
+			return
+		}
+		info["freq"] = freq
+		info["preset"] = 0
+		c.set(c.d.tuner, "band", band, "tuner", "play_info_updated", true)
+	})
+	recallTuner := func(c *call, num int) {
+		p := c.d.tunerPreset["common"][num-1].(map[string]any)
+		band, _ := p["band"].(string)
+		if band == "unknown" {
+			c.fail(4)
+			return
+		}
+		info := c.d.tuner[band].(map[string]any)
+		info["freq"] = p["number"]
+		info["preset"] = num
+		c.d.tuner["band"] = band
+		c.event("tuner", "play_info_updated", true)
+	}
+	handlers["tuner/recallPreset"] = get(func(c *call) {
+		zone := c.choice("zone", zones...)
+		c.choice("band", bands...)
+		num := c.num("num", 1, 40)
+		if !c.ok() {
+			return
+		}
+		recallTuner(c, num)
+		if c.ok() {
+			c.selectInput(zone, "tuner")
+		}
+	})
+	handlers["tuner/switchPreset"] = get(func(c *call) {
+		dir := c.choice("dir", "next", "previous")
+		if !c.ok() {
+			return
+		}
+		band := c.d.tuner["band"].(string)
+		cur := 0
+		if info, ok := c.d.tuner[band].(map[string]any); ok {
+			cur, _ = info["preset"].(int)
+		}
+		step := 1
+		if dir == "previous" {
+			step = -1
+		}
+		for i := 1; i <= 40; i++ {
+			n := ((cur-1+step*i)%40+40)%40 + 1
+			if c.d.tunerPreset["common"][n-1].(map[string]any)["band"] != "unknown" {
+				recallTuner(c, n)
+				return
+			}
+		}
+		c.fail(4)
+	})
+	handlers["tuner/storePreset"] = get(func(c *call) {
+		num := c.num("num", 1, 40)
+		if !c.ok() {
+			return
+		}
+		band := c.d.tuner["band"].(string)
+		info := c.d.tuner[band].(map[string]any)
+		c.d.tunerPreset["common"][num-1] = map[string]any{"band": band, "number": info["freq"]}
+		info["preset"] = num
+		c.event("tuner", "preset_info_updated", true)
+	})
+	handlers["tuner/clearPreset"] = get(func(c *call) {
+		c.choice("band", bands...)
+		num := c.num("num", 1, 40)
+		if !c.ok() {
+			return
+		}
+		c.d.tunerPreset["common"][num-1] = map[string]any{"band": "unknown", "number": 0}
+		c.event("tuner", "preset_info_updated", true)
+	})
+	handlers["tuner/movePreset"] = get(func(c *call) {
+		c.choice("band", bands...)
+		from := c.num("from", 1, 40)
+		to := c.num("to", 1, 40)
+		if !c.ok() {
+			return
+		}
+		list := c.d.tunerPreset["common"]
+		list[from-1], list[to-1] = list[to-1], list[from-1]
+		c.event("tuner", "preset_info_updated", true)
+	})
+	handlers["tuner/startAutoPreset"] = noop(func(c *call) { c.choice("band", "fm") })
+	handlers["tuner/cancelAutoPreset"] = noop()
+	handlers["tuner/startDabInitialScan"] = noop()
+	handlers["tuner/cancelDabInitialScan"] = noop()
+	handlers["tuner/setDabService"] = get(func(c *call) {
+		c.choice("dir", "next", "previous")
+		if c.ok() {
+			c.event("tuner", "play_info_updated", true)
+		}
+	})
+
+	// netusb
+	handlers["netusb/getPresetInfo"] = returns(func(d *Device) map[string]any {
+		return map[string]any{"preset_info": d.netPresets, "func_list": []any{"clear", "move"}}
+	})
+	handlers["netusb/getPlayInfo"] = returns(func(d *Device) map[string]any { return d.netusb })
+	handlers["netusb/setPlayback"] = get(func(c *call) {
+		v := c.choice("playback", "play", "stop", "pause", "play_pause", "previous", "next",
+			"fast_reverse_start", "fast_reverse_end", "fast_forward_start", "fast_forward_end")
+		if !c.ok() {
+			return
+		}
+		n := c.d.netusb
+		switch v {
+		case "play_pause":
+			if n["playback"] == "play" {
+				n["playback"] = "pause"
+			} else {
+				n["playback"] = "play"
+			}
+		case "next", "previous":
+			track := 1
+			if t, ok := strings.CutPrefix(n["track"].(string), "Mock Track "); ok {
+				track, _ = strconv.Atoi(t)
+			}
+			if v == "next" {
+				track++
+			} else if track > 1 {
+				track--
+			}
+			n["track"] = "Mock Track " + strconv.Itoa(track)
+			n["play_time"] = 0
+		case "fast_reverse_start":
+			n["playback"] = "fast_reverse"
+		case "fast_forward_start":
+			n["playback"] = "fast_forward"
+		case "fast_reverse_end", "fast_forward_end":
+			n["playback"] = "play"
+		default:
+			n["playback"] = v
+		}
+		c.event("netusb", "play_info_updated", true)
+	})
+	handlers["netusb/setPlayPosition"] = get(func(c *call) {
+		pos := c.num("position", 0, math.MaxInt32)
+		c.set(c.d.netusb, "play_time", pos, "netusb", "play_time", pos)
+	})
+	handlers["netusb/setRepeat"] = get(func(c *call) {
+		v := c.choice("mode", "off", "one", "all")
+		c.set(c.d.netusb, "repeat", v, "netusb", "play_info_updated", true)
+	})
+	handlers["netusb/setShuffle"] = get(func(c *call) {
+		v := c.choice("mode", "off", "on", "songs", "albums")
+		c.set(c.d.netusb, "shuffle", v, "netusb", "play_info_updated", true)
+	})
+	handlers["netusb/toggleRepeat"] = get(func(c *call) {
+		next := map[any]string{"off": "one", "one": "all", "all": "off"}[c.d.netusb["repeat"]]
+		c.set(c.d.netusb, "repeat", next, "netusb", "play_info_updated", true)
+	})
+	handlers["netusb/toggleShuffle"] = get(func(c *call) {
+		next := map[any]string{"off": "on", "on": "off"}[c.d.netusb["shuffle"]]
+		if next == "" {
+			next = "off"
+		}
+		c.set(c.d.netusb, "shuffle", next, "netusb", "play_info_updated", true)
+	})
+	handlers["netusb/getListInfo"] = get(func(c *call) {
+		input := c.str("input")
+		index, ok := c.optNum("index", 0, 64999)
+		if !ok {
+			index = 0
+		}
+		size, ok := c.optNum("size", 1, 8)
+		if !ok {
+			size = 8
+		}
+		if !c.ok() {
+			return
+		}
+		const total = 20
+		n := max(0, min(size, total-index))
+		items := listItems(index + n)[index:]
+		c.reply(c.d.list)
+		c.reply(map[string]any{"input": input, "index": index, "max_line": total, "list_info": items})
+	})
+	handlers["netusb/setListControl"] = get(func(c *call) {
+		if c.q.Has("list_id") {
+			c.choice("list_id", "main", "auto_complete", "search_artist", "search_track")
+		}
+		typ := c.choice("type", "select", "play", "return")
+		index, _ := c.optNum("index", 0, 64999)
+		zone := "main"
+		if c.q.Has("zone") {
+			zone = c.choice("zone", zones...)
+		}
+		if !c.ok() {
+			return
+		}
+		layer := c.d.list["menu_layer"].(int)
+		switch typ {
+		case "select":
+			c.d.list["menu_layer"] = layer + 1
+			c.d.list["menu_name"] = "Mock Item " + strconv.Itoa(index+1)
+		case "return":
+			if layer > 0 {
+				c.d.list["menu_layer"] = layer - 1
+			}
+		case "play":
+			c.d.list["playing_index"] = index
+			c.d.netusb["track"] = "Mock Item " + strconv.Itoa(index+1)
+			c.d.netusb["playback"] = "play"
+			c.selectInput(zone, c.d.list["input"].(string))
+		}
+		c.event("netusb", "list_info_updated", true)
+	})
+	handlers["netusb/setSearchString"] = post(func(c *call) {
+		if c.bodyString("string") == "" {
+			c.fail(3)
+			return
+		}
+		c.d.list["menu_name"] = "Search: " + c.bodyString("string")
+		c.event("netusb", "list_info_updated", true)
+	})
+	handlers["netusb/recallPreset"] = get(func(c *call) {
+		zone := c.choice("zone", zones...)
+		num := c.num("num", 1, 40)
+		if !c.ok() {
+			return
+		}
+		p := c.d.netPresets[num-1].(map[string]any)
+		if p["input"] == "unknown" {
+			c.fail(4)
+			return
+		}
+		c.d.netusb["artist"] = p["text"]
+		c.d.netusb["track"] = "Mock Track 1"
+		c.d.netusb["playback"] = "play"
+		c.selectInput(zone, p["input"].(string))
+		c.event("netusb", "preset_control", map[string]any{"type": "recall", "num": num, "result": "success"})
+	})
+	handlers["netusb/storePreset"] = get(func(c *call) {
+		num := c.num("num", 1, 40)
+		if !c.ok() {
+			return
+		}
+		c.d.netPresets[num-1] = map[string]any{"input": c.d.netusb["input"], "text": c.d.netusb["artist"], "attribute": 0}
+		c.event("netusb", "preset_info_updated", true)
+		c.event("netusb", "preset_control", map[string]any{"type": "store", "num": num, "result": "success"})
+	})
+	handlers["netusb/clearPreset"] = get(func(c *call) {
+		num := c.num("num", 1, 40)
+		if !c.ok() {
+			return
+		}
+		c.d.netPresets[num-1] = map[string]any{"input": "unknown", "text": "", "attribute": 0}
+		c.event("netusb", "preset_info_updated", true)
+	})
+	handlers["netusb/movePreset"] = get(func(c *call) {
+		from := c.num("from", 1, 40)
+		to := c.num("to", 1, 40)
+		if !c.ok() {
+			return
+		}
+		c.d.netPresets[from-1], c.d.netPresets[to-1] = c.d.netPresets[to-1], c.d.netPresets[from-1]
+		c.event("netusb", "preset_info_updated", true)
+	})
+	handlers["netusb/getRecentInfo"] = returns(func(d *Device) map[string]any {
+		return map[string]any{"recent_info": d.recent}
+	})
+	handlers["netusb/recallRecentItem"] = get(func(c *call) {
+		zone := c.choice("zone", zones...)
+		num := c.num("num", 1, 40)
+		if c.ok() && num > len(c.d.recent) {
+			c.fail(4)
+		}
+		if !c.ok() {
+			return
+		}
+		item := c.d.recent[num-1].(map[string]any)
+		c.d.netusb["artist"] = item["text"]
+		c.d.netusb["playback"] = "play"
+		c.selectInput(zone, item["input"].(string))
+	})
+	handlers["netusb/clearRecentInfo"] = get(func(c *call) {
+		c.d.recent = []any{}
+		c.event("netusb", "recent_info_updated", true)
+	})
+	handlers["netusb/getSettings"] = returns(func(d *Device) map[string]any { return d.netSettings })
+	handlers["netusb/setQuality"] = get(func(c *call) {
+		input := c.str("input")
+		value := c.str("value")
+		if !c.ok() {
+			return
+		}
+		c.d.netSettings["qualities"] = []any{map[string]any{"input": input, "value": value}}
+	})
+	handlers["netusb/getAccountStatus"] = returns(func(d *Device) map[string]any {
+		return map[string]any{"service_list": []any{
+			map[string]any{"id": "spotify", "registered": true, "login_status": "logged_in", "username": "mock"},
+		}}
+	})
+	handlers["netusb/getServiceInfo"] = get(func(c *call) {
+		input := c.str("input")
+		typ := c.q.Get("type")
+		if c.ok() {
+			c.reply(map[string]any{"type": typ, "input": input, "info": map[string]any{}})
+		}
+	})
+	handlers["netusb/managePlay"] = noop(func(c *call) {
+		c.choice("type", "add_bookmark", "add_track", "add_album", "add_channel_track", "add_channel_artist",
+			"add_playlist", "add_to_playlist", "thumbs_up", "thumbs_down", "mark_tired")
+		c.num("timeout", 0, 60000)
+	})
+	handlers["netusb/manageList"] = noop(func(c *call) {
+		typ := c.choice("type", "add_bookmark", "add_track", "add_album", "add_artist", "add_channel",
+			"add_playlist", "remove_bookmark", "remove_track", "remove_album", "remove_artist",
+			"remove_channel", "remove_playlist", "remove_from_playlist", "end_auto_complete")
+		if c.ok() && typ != "end_auto_complete" {
+			c.num("index", 0, 64999)
+		}
+		c.num("timeout", 0, 60000)
+	})
+	handlers["netusb/getPlayDescription"] = get(func(c *call) {
+		c.choice("type", "why_this_song")
+		c.num("timeout", 0, 60000)
+		if c.ok() {
+			c.reply(map[string]any{"description": "Mock picked this song because it plays everything."})
+		}
+	})
+	handlers["netusb/setListSortOption"] = noop(func(c *call) {
+		c.str("input")
+		c.str("type")
+	})
+
+	// cd
+	handlers["cd/getPlayInfo"] = returns(func(d *Device) map[string]any { return d.cd })
+	handlers["cd/setPlayback"] = get(func(c *call) {
+		v := c.choice("playback", "play", "stop", "pause", "previous", "next", "fast_reverse_start",
+			"fast_reverse_end", "fast_forward_start", "fast_forward_end", "track_select")
+		if !c.ok() {
+			return
+		}
+		cd := c.d.cd
+		total := cd["total_tracks"].(int)
+		track := cd["track_number"].(int)
+		switch v {
+		case "track_select":
+			track = c.num("num", 1, total)
+		case "next":
+			track = min(total, track+1)
+		case "previous":
+			track = max(1, track-1)
+		case "fast_reverse_start":
+			cd["playback"] = "fast_reverse"
+		case "fast_forward_start":
+			cd["playback"] = "fast_forward"
+		case "fast_reverse_end", "fast_forward_end":
+			cd["playback"] = "play"
+		default:
+			cd["playback"] = v
+		}
+		if !c.ok() {
+			return
+		}
+		if track != cd["track_number"] {
+			cd["track_number"] = track
+			cd["track"] = "Track " + strconv.Itoa(track)
+			cd["play_time"] = 0
+		}
+		c.event("cd", "play_info_updated", true)
+	})
+	handlers["cd/toggleTray"] = get(func(c *call) {
+		next := "open"
+		if c.d.cd["device_status"] == "open" {
+			next = "ready"
+		}
+		c.d.cd["playback"] = "stop"
+		c.set(c.d.cd, "device_status", next, "cd", "device_status", next)
+	})
+	handlers["cd/setRepeat"] = get(func(c *call) {
+		v := c.choice("mode", "off", "one", "all", "folder", "a-b")
+		c.set(c.d.cd, "repeat", v, "cd", "play_info_updated", true)
+	})
+	handlers["cd/setShuffle"] = get(func(c *call) {
+		v := c.choice("mode", "off", "on", "folder", "program")
+		c.set(c.d.cd, "shuffle", v, "cd", "play_info_updated", true)
+	})
+	handlers["cd/toggleRepeat"] = get(func(c *call) {
+		next := map[any]string{"off": "one", "one": "all", "all": "off"}[c.d.cd["repeat"]]
+		if next == "" {
+			next = "off"
+		}
+		c.set(c.d.cd, "repeat", next, "cd", "play_info_updated", true)
+	})
+	handlers["cd/toggleShuffle"] = get(func(c *call) {
+		next := "on"
+		if c.d.cd["shuffle"] != "off" {
+			next = "off"
+		}
+		c.set(c.d.cd, "shuffle", next, "cd", "play_info_updated", true)
+	})
+	handlers["cd/setDirect"] = get(func(c *call) {
+		v := c.flag("enable")
+		c.set(c.d.cd, "direct", v, "cd", "play_info_updated", true)
+	})
+
+	// clock
+	handlers["clock/getSettings"] = returns(func(d *Device) map[string]any { return d.clock })
+	handlers["clock/setAutoSync"] = get(func(c *call) {
+		v := c.flag("enable")
+		c.set(c.d.clock, "auto_sync", v, "clock", "settings_updated", true)
+	})
+	handlers["clock/setDateAndTime"] = noop(func(c *call) {
+		v := c.str("date_time")
+		if _, err := strconv.ParseUint(v, 10, 64); c.ok() && (len(v) != 12 || err != nil) {
+			c.fail(4)
+		}
+	})
+	handlers["clock/setClockFormat"] = get(func(c *call) {
+		v := c.choice("format", "12h", "24h")
+		c.set(c.d.clock, "format", v, "clock", "settings_updated", true)
+	})
+	handlers["clock/setAlarmSettings"] = post(func(c *call) {
+		alarm := c.d.clock["alarm"].(map[string]any)
+		for _, k := range []string{"alarm_on", "volume", "fade_interval", "fade_type", "mode", "repeat"} {
+			if v, ok := c.body[k]; ok {
+				alarm[k] = v
+			}
+		}
+		if detail, ok := c.body["detail"].(map[string]any); ok {
+			day, _ := detail["day"].(string)
+			if day == "" || day == "oneday" {
+				oneday := alarm["oneday"].(map[string]any)
+				for k, v := range detail {
+					if k != "day" {
+						oneday[k] = v
+					}
+				}
+			} else {
+				weekly, _ := alarm["weekly"].(map[string]any)
+				if weekly == nil {
+					weekly = map[string]any{}
+					alarm["weekly"] = weekly
+				}
+				weekly[day] = detail
+			}
+		}
+		c.event("clock", "settings_updated", true)
+	})
+
+	// dist
+	handlers["dist/getDistributionInfo"] = returns(func(d *Device) map[string]any { return d.dist })
+	handlers["dist/setServerInfo"] = post(func(c *call) {
+		if c.bodyString("group_id") == "" {
+			c.fail(3)
+			return
+		}
+		c.d.dist["group_id"] = c.bodyString("group_id")
+		if z := c.bodyString("zone"); z != "" {
+			c.d.dist["server_zone"] = z
+		}
+		list, _ := c.body["client_list"].([]any)
+		clients := []any{}
+		if c.bodyString("type") != "remove" {
+			for _, ip := range list {
+				clients = append(clients, map[string]any{"ip_address": ip, "data_type": "base"})
+			}
+		}
+		c.d.dist["client_list"] = clients
+		c.d.dist["role"] = "server"
+		c.event("dist", "dist_info_updated", true)
+	})
+	handlers["dist/setClientInfo"] = post(func(c *call) {
+		id := c.bodyString("group_id")
+		c.d.dist["group_id"] = id
+		c.d.dist["role"] = "client"
+		if id == "" || strings.Trim(id, "0") == "" {
+			c.d.dist["role"] = "none"
+		}
+		c.event("dist", "dist_info_updated", true)
+	})
+	handlers["dist/startDistribution"] = get(func(c *call) {
+		c.num("num", 0, math.MaxInt32)
+		c.set(c.d.dist, "status", "working", "dist", "dist_info_updated", true)
+	})
+	handlers["dist/stopDistribution"] = get(func(c *call) {
+		c.d.dist["client_list"] = []any{}
+		c.d.dist["role"] = "none"
+		c.set(c.d.dist, "status", "deleting", "dist", "dist_info_updated", true)
+	})
+	handlers["dist/setGroupName"] = post(func(c *call) {
+		c.set(c.d.dist, "group_name", c.bodyString("name"), "dist", "dist_info_updated", true)
+	})
+}
@@ -0,0 +1,240 @@
+// Package mock is an in-memory MusicCast device that serves the Yamaha
+// Extended Control API over HTTP and pushes UDP events to registered
+// clients, so the CLI and the yxc client can be exercised without hardware.
+package mock
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EventTimeout matches the device behaviour of dropping event subscribers
+// that have not sent a request for ten minutes.
+const EventTimeout = 10 * time.Minute
+
+const pathPrefix = "/YamahaExtendedControl/v1/"
+
+// Device is the mock. Its zero value is not usable; call New.
+type Device struct {
+	mu          sync.Mutex
+	deviceInfo  map[string]any
+	features    map[string]any
+	network     map[string]any
+	netStandby  string
+	bluetooth   map[string]any
+	btDevices   []any
+	funcStatus  map[string]any
+	location    map[string]any
+	nameText    map[string]string
+	zones       map[string]map[string]any
+	tuner       map[string]any
+	tunerPreset map[string][]any
+	netusb      map[string]any
+	netPresets  []any
+	recent      []any
+	list        map[string]any
+	netSettings map[string]any
+	cd          map[string]any
+	clock       map[string]any
+	dist        map[string]any
+	subscribers map[string]time.Time
+	failures    map[string][]int
+	calls       map[string]int
+	conn        *net.UDPConn
+	now         func() time.Time
+}
+
+func New() *Device {
+	d := &Device{
+		subscribers: map[string]time.Time{},
+		failures:    map[string][]int{},
+		calls:       map[string]int{},
+		now:         time.Now,
+	}
+	d.reset()
+	return d
+}
+
+// Fail queues response codes returned, one per call, by the next requests
+// to path (for example "main/setVolume" or "system/getDeviceInfo") before
+// the endpoint behaves normally again.
+func (d *Device) Fail(path string, codes ...int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.failures[path] = append(d.failures[path], codes...)
+}
+
+// Calls reports how many requests path has received.
+func (d *Device) Calls(path string) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.calls[path]
+}
+
+func (d *Device) DeviceID() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.deviceInfo["device_id"].(string)
+}
+
+// Close releases the socket used to send events.
+func (d *Device) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.conn == nil {
+		return nil
+	}
+	err := d.conn.Close()
+	d.conn = nil
+	return err
+}
+
+func (d *Device) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, pathPrefix) {
+		http.NotFound(w, r)
+		return
+	}
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, pathPrefix), "/")
+	group, op, ok := strings.Cut(path, "/")
+	if !ok || strings.Contains(op, "/") {
+		http.NotFound(w, r)
+		return
+	}
+	key := group + "/" + op
+	zone := ""
+	if isZone(group) {
+		zone = group
+		key = "zone/" + op
+	}
+	h, ok := handlers[key]
+	if !ok || (h.method != "" && h.method != r.Method) {
+		http.NotFound(w, r)
+		return
+	}
+	c := &call{zone: zone, q: r.URL.Query(), resp: map[string]any{}, events: map[string]map[string]any{}}
+	if r.Method == http.MethodPost {
+		body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+		if err != nil || json.Unmarshal(body, &c.body) != nil {
+			c.code = 3
+		}
+	}
+
+	d.mu.Lock()
+	d.register(r)
+	d.calls[path]++
+	if codes := d.failures[path]; len(codes) > 0 {
+		c.code = codes[0]
+		d.failures[path] = codes[1:]
+	} else if zone != "" && d.zones[zone] == nil {
+		c.code = 3
+	}
+	c.d = d
+	if c.code == 0 {
+		h.fn(c)
+	}
+	var event []byte
+	if c.code == 0 && len(c.events) > 0 {
+		event = d.encodeEvent(c.events)
+	}
+	targets := d.liveSubscribers()
+	d.mu.Unlock()
+
+	resp := c.resp
+	if c.code != 0 {
+		resp = map[string]any{}
+	}
+	resp["response_code"] = c.code
+	out, _ := json.Marshal(resp)
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(out)
+	if event != nil {
+		d.send(targets, event)
+	}
+}
+
+func isZone(group string) bool {
+	switch group {
+	case "main", "zone2", "zone3", "zone4":
+		return true
+	}
+	return false
+}
+
+// register records the sender as an event subscriber when the request
+// carries X-AppName and X-AppPort, refreshing its expiry.
+func (d *Device) register(r *http.Request) {
+	if r.Header.Get("X-AppName") == "" {
+		return
+	}
+	port, err := strconv.Atoi(r.Header.Get("X-AppPort"))
+	if err != nil || port <= 0 || port > 65535 {
+		return
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return
+	}
+	d.subscribers[net.JoinHostPort(host, strconv.Itoa(port))] = d.now().Add(EventTimeout)
+}
+
+func (d *Device) liveSubscribers() []string {
+	now := d.now()
+	out := []string{}
+	for addr, expiry := range d.subscribers {
+		if now.After(expiry) {
+			delete(d.subscribers, addr)
+			continue
+		}
+		out = append(out, addr)
+	}
+	return out
+}
+
+func (d *Device) encodeEvent(events map[string]map[string]any) []byte {
+	msg := map[string]any{"device_id": d.deviceInfo["device_id"]}
+	for section, fields := range events {
+		msg[section] = fields
+	}
+	out, _ := json.Marshal(msg)
+	return out
+}
+
+func (d *Device) send(targets []string, event []byte) {
+	if len(targets) == 0 {
+		return
+	}
+	d.mu.Lock()
+	if d.conn == nil {
+		conn, err := net.ListenUDP("udp", &net.UDPAddr{})
+		if err != nil {
+			d.mu.Unlock()
+			return
+		}
+		d.conn = conn
+	}
+	conn := d.conn
+	d.mu.Unlock()
+	for _, t := range targets {
+		addr, err := net.ResolveUDPAddr("udp", t)
+		if err != nil {
+			continue
+		}
+		_, _ = conn.WriteToUDP(event, addr)
+	}
+}
+
+// Notify pushes an arbitrary event to every live subscriber, as if the
+// device state had changed on its own (front panel, remote, another app).
+func (d *Device) Notify(section string, fields map[string]any) {
+	d.mu.Lock()
+	event := d.encodeEvent(map[string]map[string]any{section: fields})
+	targets := d.liveSubscribers()
+	d.mu.Unlock()
+	d.send(targets, event)
+}
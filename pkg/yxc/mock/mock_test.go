@@ -0,0 +1,105 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/amannm/yxc/pkg/yxc"
+)
+
+func TestSetVolumeReflectedInStatus(t *testing.T) {
+	d := New()
+	defer d.Close()
+	srv := httptest.NewServer(d)
+	defer srv.Close()
+
+	ctx := context.Background()
+	z := yxc.New(yxc.Config{BaseURL: srv.URL + "/YamahaExtendedControl"}).Zone("main")
+	if _, err := z.SetVolume(ctx, 42); err != nil {
+		t.Fatalf("set volume: %v", err)
+	}
+	st, err := z.GetStatus(ctx)
+	if err != nil {
+		t.Fatalf("get status: %v", err)
+	}
+	if st.Volume != 42 {
+		t.Fatalf("volume = %d, want 42", st.Volume)
+	}
+	if st.ActualVolume == nil || st.ActualVolume.Value != -59.5 {
+		t.Fatalf("actual volume = %+v, want -59.5 dB", st.ActualVolume)
+	}
+
+	_, err = z.SetVolume(ctx, maxVolume+1)
+	var rerr *yxc.ResponseError
+	if !errors.As(err, &rerr) || rerr.Code != 4 {
+		t.Fatalf("out of range volume: err = %v, want response_code 4", err)
+	}
+
+	d.Fail("main/getStatus", 1)
+	if _, err := z.GetStatus(ctx); !errors.As(err, &rerr) || rerr.Code != 1 {
+		t.Fatalf("queued failure: err = %v, want response_code 1", err)
+	}
+	if _, err := z.GetStatus(ctx); err != nil {
+		t.Fatalf("get status after failure: %v", err)
+	}
+}
+
+// TestStateFollow checks that a change made while the state is loading
+// reaches the followed state, and that an event repeating a cached value is
+// not reported as a change.
+func TestStateFollow(t *testing.T) {
+	d := New()
+	defer d.Close()
+	var once sync.Once
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		d.ServeHTTP(w, r)
+		if strings.HasSuffix(r.URL.Path, "/main/getStatus") {
+			// The volume is turned up right after the zone was loaded.
+			once.Do(func() { d.Notify("main", map[string]any{"volume": 50}) })
+		}
+	}))
+	defer srv.Close()
+	l, err := yxc.ListenEvents("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	s := yxc.New(yxc.Config{BaseURL: srv.URL + "/YamahaExtendedControl"}).NewState()
+	volume := func() any {
+		return s.Snapshot()["zones"].(map[string]any)["main"].(map[string]any)["volume"]
+	}
+	var calls [][]string
+	err = s.Follow(ctx, l, "test", 0, func(changed []string) error {
+		calls = append(calls, changed)
+		switch len(calls) {
+		case 2:
+			if v := volume(); v != 50.0 {
+				t.Errorf("volume after the buffered event = %v, want 50", v)
+			}
+			d.Notify("main", map[string]any{"volume": 50})
+			d.Notify("main", map[string]any{"volume": 51})
+		case 3:
+			cancel()
+		}
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("follow: %v (callbacks %q)", err, calls)
+	}
+	if len(calls) != 3 || calls[0] != nil || !slices.Equal(calls[2], []string{"zones.main"}) {
+		t.Errorf("callbacks = %q, want the load, then one per volume change", calls)
+	}
+	if v := volume(); v != 51.0 {
+		t.Errorf("volume = %v, want 51", v)
+	}
+}
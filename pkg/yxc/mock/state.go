@@ -0,0 +1,372 @@
+package mock
+
+import "fmt"
+
+const (
+	maxVolume = 161
+	minDB     = -80.5
+	dbStep    = 0.5
+)
+
+var (
+	inputs = []string{
+		"net_radio", "server", "spotify", "airplay", "usb", "bluetooth",
+		"tuner", "cd", "hdmi1", "hdmi2", "hdmi3", "hdmi4", "av1", "audio1", "optical1",
+	}
+	zone2Inputs = []string{"net_radio", "server", "spotify", "airplay", "usb", "bluetooth", "tuner", "cd", "audio1"}
+	programs    = []string{
+		"munich", "vienna", "chamber", "cellar_club", "the_roxy_theatre", "the_bottom_line",
+		"sports", "action_game", "roleplaying_game", "music_video", "standard", "spectacle",
+		"sci-fi", "adventure", "drama", "mono_movie", "2ch_stereo", "all_ch_stereo",
+		"surr_decoder", "straight",
+	}
+	playInfoType = map[string]string{
+		"net_radio": "netusb", "server": "netusb", "spotify": "netusb", "airplay": "netusb",
+		"usb": "netusb", "bluetooth": "netusb", "tuner": "tuner", "cd": "cd",
+	}
+)
+
+type rangeStep struct {
+	min, max, step float64
+}
+
+var zoneRanges = map[string]rangeStep{
+	"volume":           {0, maxVolume, 1},
+	"actual_volume_db": {minDB, minDB + maxVolume*dbStep, dbStep},
+	"tone_control":     {-12, 12, 1},
+	"equalizer":        {-10, 10, 1},
+	"balance":          {-10, 10, 1},
+	"dialogue_level":   {0, 3, 1},
+	"dialogue_lift":    {0, 5, 1},
+	"subwoofer_volume": {-6, 6, 1},
+}
+
+func (d *Device) reset() {
+	d.deviceInfo = map[string]any{
+		"model_name":           "RX-V6A",
+		"destination":          "U",
+		"device_id":            "00A0DEMOCK01",
+		"system_version":       2.71,
+		"api_version":          2.12,
+		"netmodule_generation": 1,
+		"netmodule_version":    "2734",
+		"netmodule_checksum":   "6E9A2F1C",
+		"serial_number":        "Y1234567MK",
+		"category_code":        1,
+	}
+	d.features = d.buildFeatures()
+	d.network = map[string]any{
+		"network_name":    "Mock Living Room",
+		"connection":      "wired_lan",
+		"dhcp":            true,
+		"ip_address":      "127.0.0.1",
+		"subnet_mask":     "255.255.255.0",
+		"default_gateway": "127.0.0.1",
+		"dns_server_1":    "127.0.0.1",
+		"dns_server_2":    "0.0.0.0",
+		"wireless_lan":    map[string]any{"ssid": "", "type": "none", "key": "", "ch": 0, "signal_level": 0},
+		"mac_address": map[string]any{
+			"wired_lan":       "00A0DE000001",
+			"wireless_lan":    "00A0DE000002",
+			"wireless_direct": "00A0DE000003",
+		},
+	}
+	d.netStandby = "on"
+	d.bluetooth = map[string]any{
+		"bluetooth_standby":    false,
+		"bluetooth_tx_setting": false,
+		"bluetooth_device":     map[string]any{"connected": false, "name": "", "type": "unknown", "address": ""},
+	}
+	d.btDevices = []any{
+		map[string]any{"name": "Mock Headphones", "type": "headphone", "address": "0011223344AA"},
+		map[string]any{"name": "Mock Speaker", "type": "loudspeaker", "address": "0011223344BB"},
+	}
+	d.funcStatus = map[string]any{
+		"auto_power_standby": true,
+		"ir_sensor":          true,
+		"speaker_a":          true,
+		"speaker_b":          false,
+		"headphone":          false,
+		"dimmer":             -1,
+		"zone_b_volume_sync": false,
+		"hdmi_out_1":         true,
+		"hdmi_out_2":         false,
+		"hdmi_out_3":         false,
+		"auto_play":          true,
+		"speaker_pattern":    1,
+		"party_mode":         false,
+	}
+	d.location = map[string]any{
+		"id":        "mock-location",
+		"name":      "Mock Home",
+		"zone_list": map[string]any{"main": true, "zone2": true, "zone3": false, "zone4": false},
+	}
+	d.nameText = map[string]string{"main": "Living Room", "zone2": "Kitchen"}
+	for _, in := range inputs {
+		d.nameText[in] = in
+	}
+	d.zones = map[string]map[string]any{
+		"main":  newZoneStatus(60, "net_radio", "standard"),
+		"zone2": newZoneStatus(40, "net_radio", ""),
+	}
+	d.tuner = map[string]any{
+		"band":        "fm",
+		"auto_scan":   false,
+		"auto_preset": false,
+		"am":          map[string]any{"preset": 0, "freq": 630, "tuned": true},
+		"fm":          map[string]any{"preset": 1, "freq": 98100, "tuned": true, "audio_mode": "stereo"},
+		"rds": map[string]any{
+			"program_type":    "POP M",
+			"program_service": "MOCK FM",
+			"radio_text_a":    "Mock radio text",
+			"radio_text_b":    "",
+		},
+		"dab": map[string]any{
+			"preset": 0, "id": 0, "status": "ready", "freq": 0, "category": "primary",
+			"audio_mode": "stereo", "bit_rate": 128, "quality": 80, "tune_aid": 0,
+			"off_air": false, "dab_plus": true, "program_type": "", "ch_label": "",
+			"service_label": "", "dls": "", "ensemble_label": "",
+		},
+	}
+	common := make([]any, 40)
+	for i := range common {
+		common[i] = map[string]any{"band": "unknown", "number": 0}
+	}
+	common[0] = map[string]any{"band": "fm", "number": 98100}
+	common[1] = map[string]any{"band": "fm", "number": 101500}
+	common[2] = map[string]any{"band": "am", "number": 630}
+	d.tunerPreset = map[string][]any{"common": common}
+	d.netusb = map[string]any{
+		"input":             "net_radio",
+		"playback":          "play",
+		"repeat":            "off",
+		"shuffle":           "off",
+		"repeat_available":  []any{"off", "one", "all"},
+		"shuffle_available": []any{"off", "on"},
+		"play_time":         0,
+		"total_time":        0,
+		"artist":            "Mock Station",
+		"album":             "",
+		"track":             "Mock Track 1",
+		"albumart_url":      "/YamahaRemoteControl/AlbumART/AlbumART.jpg",
+		"albumart_id":       1,
+		"usb_devicetype":    "unknown",
+		"attribute":         0,
+	}
+	d.netPresets = make([]any, 40)
+	for i := range d.netPresets {
+		d.netPresets[i] = map[string]any{"input": "unknown", "text": "", "attribute": 0}
+	}
+	d.netPresets[0] = map[string]any{"input": "net_radio", "text": "Mock Jazz", "attribute": 0}
+	d.netPresets[1] = map[string]any{"input": "spotify", "text": "Mock Playlist", "attribute": 0}
+	d.recent = []any{
+		map[string]any{"input": "net_radio", "text": "Mock Jazz", "albumart_url": "", "attribute": 0},
+		map[string]any{"input": "server", "text": "Mock Album", "albumart_url": "", "attribute": 0},
+	}
+	d.list = map[string]any{"input": "server", "menu_layer": 0, "index": 0, "playing_index": -1, "menu_name": "Mock Server"}
+	d.netSettings = map[string]any{
+		"auto_stop": true,
+		"qualities": []any{map[string]any{"input": "spotify", "value": "high"}},
+	}
+	d.cd = map[string]any{
+		"device_status":     "ready",
+		"playback":          "stop",
+		"repeat":            "off",
+		"shuffle":           "off",
+		"repeat_available":  []any{"off", "one", "all"},
+		"shuffle_available": []any{"off", "on"},
+		"play_time":         0,
+		"total_time":        215,
+		"disc_time":         2580,
+		"track_number":      1,
+		"total_tracks":      12,
+		"artist":            "Mock Artist",
+		"album":             "Mock Album",
+		"track":             "Track 1",
+	}
+	d.clock = map[string]any{
+		"auto_sync": true,
+		"format":    "24h",
+		"alarm": map[string]any{
+			"alarm_on":      false,
+			"volume":        40,
+			"fade_interval": 0,
+			"fade_type":     1,
+			"mode":          "oneday",
+			"repeat":        false,
+			"oneday":        map[string]any{"enable": false, "time": "0700", "beep": false, "playback_type": "resume"},
+		},
+	}
+	d.dist = map[string]any{
+		"group_id":      "00000000000000000000000000000000",
+		"group_name":    "",
+		"role":          "none",
+		"server_zone":   "main",
+		"client_list":   []any{},
+		"build_disable": []any{},
+		"audio_dropout": false,
+	}
+}
+
+func newZoneStatus(volume int, input, program string) map[string]any {
+	z := map[string]any{
+		"power":               "on",
+		"sleep":               0,
+		"volume":              volume,
+		"mute":                false,
+		"max_volume":          maxVolume,
+		"input":               input,
+		"input_text":          input,
+		"distribution_enable": true,
+		"link_control":        "standard",
+		"link_audio_delay":    "audio_sync",
+		"link_audio_quality":  "uncompressed",
+		"disable_flags":       0,
+		"actual_volume":       actualVolume(volume),
+	}
+	if program != "" {
+		z["sound_program"] = program
+		z["surr_decoder_type"] = "auto"
+		z["surround_3d"] = false
+		z["direct"] = false
+		z["pure_direct"] = false
+		z["enhancer"] = true
+		z["tone_control"] = map[string]any{"mode": "manual", "bass": 0, "treble": 0}
+		z["equalizer"] = map[string]any{"mode": "manual", "low": 0, "mid": 0, "high": 0}
+		z["balance"] = 0
+		z["dialogue_level"] = 0
+		z["dialogue_lift"] = 0
+		z["clear_voice"] = false
+		z["subwoofer_volume"] = 0
+		z["bass_extension"] = false
+	}
+	return z
+}
+
+func actualVolume(volume int) map[string]any {
+	return map[string]any{"mode": "db", "value": minDB + float64(volume)*dbStep, "unit": "dB"}
+}
+
+func (d *Device) buildFeatures() map[string]any {
+	inputList := []any{}
+	for _, in := range inputs {
+		t := playInfoType[in]
+		if t == "" {
+			t = "none"
+		}
+		inputList = append(inputList, map[string]any{
+			"id":                  in,
+			"distribution_enable": t != "cd",
+			"rename_enable":       true,
+			"account_enable":      in == "spotify",
+			"play_info_type":      t,
+		})
+	}
+	ranges := func(ids ...string) []any {
+		out := []any{}
+		for _, id := range ids {
+			r := zoneRanges[id]
+			out = append(out, map[string]any{"id": id, "min": r.min, "max": r.max, "step": r.step})
+		}
+		return out
+	}
+	return map[string]any{
+		"system": map[string]any{
+			"func_list": []any{
+				"wired_lan", "wireless_lan", "network_standby", "bluetooth_standby", "bluetooth_tx_setting",
+				"auto_power_standby", "ir_sensor", "speaker_a", "speaker_b", "dimmer", "zone_b_volume_sync",
+				"hdmi_out_1", "hdmi_out_2", "airplay", "auto_play", "speaker_pattern", "party_mode",
+			},
+			"zone_num":   2,
+			"input_list": inputList,
+		},
+		"zone": []any{
+			map[string]any{
+				"id": "main",
+				"func_list": []any{
+					"power", "sleep", "volume", "mute", "sound_program", "surround_3d", "direct", "pure_direct",
+					"enhancer", "tone_control", "equalizer", "balance", "dialogue_level", "dialogue_lift",
+					"clear_voice", "subwoofer_volume", "bass_extension", "signal_info", "prepare_input_change",
+					"link_control", "link_audio_delay", "link_audio_quality", "scene", "contents_display",
+					"cursor", "menu", "actual_volume", "surr_decoder_type",
+				},
+				"input_list":         toAny(inputs),
+				"sound_program_list": toAny(programs),
+				"range_step": ranges("volume", "actual_volume_db", "tone_control", "equalizer", "balance",
+					"dialogue_level", "dialogue_lift", "subwoofer_volume"),
+				"scene_num":                 8,
+				"cursor_list":               []any{"up", "down", "left", "right", "select", "return"},
+				"menu_list":                 []any{"on_screen", "top_menu", "menu", "option", "display", "help", "home", "mode", "red", "green", "yellow", "blue"},
+				"actual_volume_mode_list":   []any{"db", "numeric"},
+				"surr_decoder_type_list":    []any{"toggle", "auto", "dolby_surround", "dts_neural_x"},
+				"link_control_list":         []any{"standard", "stability", "speed"},
+				"link_audio_delay_list":     []any{"lip_sync", "audio_sync"},
+				"link_audio_quality_list":   []any{"compressed", "uncompressed"},
+				"ccs_supported":             []any{},
+				"zone_b":                    false,
+				"tone_control_mode_list":    []any{"manual"},
+				"equalizer_mode_list":       []any{"manual", "bypass"},
+				"input_select_supported":    true,
+				"distribution_input_select": true,
+			},
+			map[string]any{
+				"id":         "zone2",
+				"func_list":  []any{"power", "sleep", "volume", "mute", "prepare_input_change", "link_control", "actual_volume"},
+				"input_list": toAny(zone2Inputs),
+				"range_step": ranges("volume", "actual_volume_db"),
+			},
+		},
+		"tuner": map[string]any{
+			"func_list": []any{"am", "fm", "rds", "dab"},
+			"range_step": []any{
+				map[string]any{"id": "am", "min": 531, "max": 1611, "step": 9},
+				map[string]any{"id": "fm", "min": 87500, "max": 108000, "step": 50},
+			},
+			"preset": map[string]any{"type": "common", "num": 40},
+		},
+		"netusb": map[string]any{
+			"func_list": []any{
+				"recent_info", "play_queue", "mc_playlist", "streaming_service_use", "play_position",
+			},
+			"preset":      map[string]any{"num": 40},
+			"recent_info": map[string]any{"num": 40},
+		},
+		"distribution": map[string]any{
+			"version":           2.0,
+			"compatible_client": []any{2},
+			"client_max":        9,
+			"server_zone_list":  []any{"main"},
+		},
+		"clock": map[string]any{
+			"func_list": []any{"date_and_time", "alarm"},
+		},
+	}
+}
+
+func toAny(items []string) []any {
+	out := make([]any, len(items))
+	for i, s := range items {
+		out[i] = s
+	}
+	return out
+}
+
+func (d *Device) zoneInputs(zone string) []string {
+	if zone == "main" {
+		return inputs
+	}
+	return zone2Inputs
+}
+
+func listItems(n int) []any {
+	out := make([]any, n)
+	for i := range out {
+		out[i] = map[string]any{
+			"text":      fmt.Sprintf("Mock Item %d", i+1),
+			"subtexts":  []any{},
+			"thumbnail": "",
+			"attribute": 2,
+		}
+	}
+	return out
+}
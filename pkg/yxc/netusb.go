@@ -0,0 +1,237 @@
+package yxc
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type NetUSBService struct {
+	c *Client
+}
+
+func (c *Client) NetUSB() *NetUSBService {
+	return &NetUSBService{c: c}
+}
+
+type NetUSBPreset struct {
+	Input     string `json:"input"`
+	Text      string `json:"text"`
+	Attribute int    `json:"attribute"`
+}
+
+type NetUSBPresetInfo struct {
+	Response
+	PresetInfo []NetUSBPreset `json:"preset_info"`
+	FuncList   []string       `json:"func_list"`
+}
+
+type NetUSBPlayInfo struct {
+	Response
+	Input            string   `json:"input"`
+	Playback         string   `json:"playback"`
+	Repeat           string   `json:"repeat"`
+	Shuffle          string   `json:"shuffle"`
+	RepeatAvailable  []string `json:"repeat_available"`
+	ShuffleAvailable []string `json:"shuffle_available"`
+	PlayTime         int      `json:"play_time"`
+	TotalTime        int      `json:"total_time"`
+	Artist           string   `json:"artist"`
+	Album            string   `json:"album"`
+	Track            string   `json:"track"`
+	AlbumartURL      string   `json:"albumart_url"`
+	AlbumartID       int      `json:"albumart_id"`
+	USBDeviceType    string   `json:"usb_devicetype"`
+	Attribute        int      `json:"attribute"`
+}
+
+type ListItem struct {
+	Text      string   `json:"text"`
+	Subtexts  []string `json:"subtexts,omitempty"`
+	Thumbnail string   `json:"thumbnail,omitempty"`
+	Attribute int      `json:"attribute"`
+}
+
+type ListInfo struct {
+	Response
+	Input        string     `json:"input"`
+	MenuLayer    int        `json:"menu_layer"`
+	MaxLine      int        `json:"max_line"`
+	Index        int        `json:"index"`
+	PlayingIndex int        `json:"playing_index"`
+	MenuName     string     `json:"menu_name"`
+	ListInfo     []ListItem `json:"list_info"`
+}
+
+type RecentItem struct {
+	Input       string `json:"input"`
+	Text        string `json:"text"`
+	AlbumartURL string `json:"albumart_url"`
+	Attribute   int    `json:"attribute"`
+}
+
+type RecentInfo struct {
+	Response
+	RecentInfo []RecentItem `json:"recent_info"`
+}
+
+type ListInfoRequest struct {
+	Input string
+	Index *int
+	Size  *int
+	Lang  string
+}
+
+type ListControlRequest struct {
+	ListID string
+	Type   string
+	Index  *int
+	Zone   string
+}
+
+type SearchRequest struct {
+	ListID string `json:"list_id,omitempty"`
+	String string `json:"string"`
+}
+
+func (n *NetUSBService) GetPresetInfo(ctx context.Context) (*NetUSBPresetInfo, error) {
+	out := &NetUSBPresetInfo{}
+	return out, n.c.get(ctx, "netusb/getPresetInfo", nil, out)
+}
+
+func (n *NetUSBService) GetPlayInfo(ctx context.Context) (*NetUSBPlayInfo, error) {
+	out := &NetUSBPlayInfo{}
+	return out, n.c.get(ctx, "netusb/getPlayInfo", nil, out)
+}
+
+func (n *NetUSBService) SetPlayback(ctx context.Context, playback string) (*Response, error) {
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/setPlayback", setQuery("playback", playback), out)
+}
+
+func (n *NetUSBService) SetPlayPosition(ctx context.Context, position int) (*Response, error) {
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/setPlayPosition", setQuery("position", strconv.Itoa(position)), out)
+}
+
+func (n *NetUSBService) SetRepeat(ctx context.Context, mode string) (*Response, error) {
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/setRepeat", setQuery("mode", mode), out)
+}
+
+func (n *NetUSBService) SetShuffle(ctx context.Context, mode string) (*Response, error) {
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/setShuffle", setQuery("mode", mode), out)
+}
+
+func (n *NetUSBService) ToggleRepeat(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/toggleRepeat", nil, out)
+}
+
+func (n *NetUSBService) ToggleShuffle(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/toggleShuffle", nil, out)
+}
+
+func (n *NetUSBService) GetListInfo(ctx context.Context, r ListInfoRequest) (*ListInfo, error) {
+	q := setQuery("input", r.Input)
+	if r.Index != nil {
+		q.Set("index", strconv.Itoa(*r.Index))
+	}
+	if r.Size != nil {
+		q.Set("size", strconv.Itoa(*r.Size))
+	}
+	if strings.TrimSpace(r.Lang) != "" {
+		q.Set("lang", r.Lang)
+	}
+	out := &ListInfo{}
+	return out, n.c.get(ctx, "netusb/getListInfo", q, out)
+}
+
+func (n *NetUSBService) SetListControl(ctx context.Context, r ListControlRequest) (*Response, error) {
+	q := url.Values{}
+	if strings.TrimSpace(r.ListID) != "" {
+		q.Set("list_id", r.ListID)
+	}
+	q.Set("type", r.Type)
+	if r.Index != nil {
+		q.Set("index", strconv.Itoa(*r.Index))
+	}
+	q.Set("zone", zoneOrDefault(r.Zone))
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/setListControl", q, out)
+}
+
+func (n *NetUSBService) SetSearchString(ctx context.Context, r SearchRequest) (*Response, error) {
+	out := &Response{}
+	return out, n.c.post(ctx, "netusb/setSearchString", r, out)
+}
+
+func (n *NetUSBService) RecallPreset(ctx context.Context, zone string, num int) (*Response, error) {
+	q := setQuery("zone", zoneOrDefault(zone))
+	q.Set("num", strconv.Itoa(num))
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/recallPreset", q, out)
+}
+
+func (n *NetUSBService) StorePreset(ctx context.Context, num int) (*Response, error) {
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/storePreset", setQuery("num", strconv.Itoa(num)), out)
+}
+
+func (n *NetUSBService) ClearPreset(ctx context.Context, num int) (*Response, error) {
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/clearPreset", setQuery("num", strconv.Itoa(num)), out)
+}
+
+func (n *NetUSBService) MovePreset(ctx context.Context, from, to int) (*Response, error) {
+	q := setQuery("from", strconv.Itoa(from))
+	q.Set("to", strconv.Itoa(to))
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/movePreset", q, out)
+}
+
+func (n *NetUSBService) GetRecentInfo(ctx context.Context) (*RecentInfo, error) {
+	out := &RecentInfo{}
+	return out, n.c.get(ctx, "netusb/getRecentInfo", nil, out)
+}
+
+func (n *NetUSBService) RecallRecentItem(ctx context.Context, zone string, num int) (*Response, error) {
+	q := setQuery("zone", zoneOrDefault(zone))
+	q.Set("num", strconv.Itoa(num))
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/recallRecentItem", q, out)
+}
+
+func (n *NetUSBService) ClearRecentInfo(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/clearRecentInfo", nil, out)
+}
+
+func (n *NetUSBService) GetSettings(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/getSettings", nil, out)
+}
+
+func (n *NetUSBService) SetQuality(ctx context.Context, input, value string) (*Response, error) {
+	q := setQuery("input", input)
+	q.Set("value", value)
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/setQuality", q, out)
+}
+
+func (n *NetUSBService) GetAccountStatus(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/getAccountStatus", nil, out)
+}
+
+func (n *NetUSBService) GetServiceInfo(ctx context.Context, input, typ string) (*Response, error) {
+	q := setQuery("input", input)
+	if strings.TrimSpace(typ) != "" {
+		q.Set("type", typ)
+	}
+	out := &Response{}
+	return out, n.c.get(ctx, "netusb/getServiceInfo", q, out)
+}
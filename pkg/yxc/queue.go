@@ -0,0 +1,127 @@
+package yxc
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Throttle limits the load put on one device. Devices handle few concurrent
+// requests, so every client in the process that talks to the same host
+// shares one HostQueue. The zero value imposes no limits.
+//
+// MinGap is the minimum time between the starts of two requests and
+// MaxInFlight caps concurrent requests. When LockDir is set, the queue also
+// holds an exclusive lock file there while it has requests in flight, so
+// separate processes take turns against the same host and honor MinGap
+// across invocations.
+type Throttle struct {
+	MinGap      time.Duration
+	MaxInFlight int
+	LockDir     string
+}
+
+// HostQueue admits requests to a single host.
+type HostQueue struct {
+	slots   chan struct{}
+	gap     time.Duration
+	lock    *fileLock
+	mu      sync.Mutex
+	last    time.Time
+	holders int
+}
+
+var (
+	queuesMu sync.Mutex
+	queues   = map[string]*HostQueue{}
+)
+
+// HostQueueFor returns the process-wide queue for host ("name:port"). The
+// throttle of the first caller for a host applies to all later ones.
+func HostQueueFor(host string, t Throttle) *HostQueue {
+	queuesMu.Lock()
+	defer queuesMu.Unlock()
+	if q, ok := queues[host]; ok {
+		return q
+	}
+	q := &HostQueue{gap: t.MinGap}
+	if t.MaxInFlight > 0 {
+		q.slots = make(chan struct{}, t.MaxInFlight)
+	}
+	if t.LockDir != "" {
+		q.lock = &fileLock{path: filepath.Join(t.LockDir, lockName(host))}
+	}
+	queues[host] = q
+	return q
+}
+
+func lockName(host string) string {
+	return strings.NewReplacer(":", "_", "/", "_", "[", "", "]", "").Replace(host) + ".lock"
+}
+
+// Acquire blocks until a request may be sent and returns the function that
+// releases its slot once the response has been read.
+func (q *HostQueue) Acquire(ctx context.Context) (func(), error) {
+	if q.slots != nil {
+		select {
+		case q.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	wait, err := q.reserve(ctx)
+	if err != nil {
+		if q.slots != nil {
+			<-q.slots
+		}
+		return nil, err
+	}
+	if err := sleep(ctx, wait); err != nil {
+		q.release()
+		return nil, err
+	}
+	var once sync.Once
+	return func() { once.Do(q.release) }, nil
+}
+
+// reserve claims the next start time and returns how long to wait for it.
+func (q *HostQueue) reserve(ctx context.Context) (time.Duration, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.holders == 0 && q.lock != nil {
+		last, err := q.lock.acquire(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if last.After(q.last) {
+			q.last = last
+		}
+	}
+	q.holders++
+	now := time.Now()
+	start := now
+	if next := q.last.Add(q.gap); next.After(now) {
+		start = next
+	}
+	q.last = start
+	if q.lock != nil {
+		q.lock.stamp(start)
+	}
+	return start.Sub(now), nil
+}
+
+func (q *HostQueue) release() {
+	q.mu.Lock()
+	if q.holders > 0 {
+		q.holders--
+		if q.holders == 0 && q.lock != nil {
+			q.lock.release()
+		}
+	}
+	q.mu.Unlock()
+	if q.slots != nil {
+		<-q.slots
+	}
+}
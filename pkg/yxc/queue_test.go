@@ -0,0 +1,50 @@
+package yxc
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHostQueueLimits(t *testing.T) {
+	q := HostQueueFor("queue-test:80", Throttle{MinGap: 5 * time.Millisecond, MaxInFlight: 2})
+	var inFlight, peak atomic.Int32
+	var mu sync.Mutex
+	var starts []time.Time
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := q.Acquire(context.Background())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer release()
+			mu.Lock()
+			starts = append(starts, time.Now())
+			mu.Unlock()
+			n := inFlight.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			inFlight.Add(-1)
+		}()
+	}
+	wg.Wait()
+	if p := peak.Load(); p > 2 {
+		t.Fatalf("peak in flight = %d, want <= 2", p)
+	}
+	slices.SortFunc(starts, time.Time.Compare)
+	if span := starts[len(starts)-1].Sub(starts[0]); span < 5*5*time.Millisecond {
+		t.Fatalf("6 requests started within %s, want >= 25ms", span)
+	}
+}
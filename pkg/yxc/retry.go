@@ -0,0 +1,98 @@
+package yxc
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrFirmwareWaitExpired wraps the last Firmware Updating response when
+// RetryPolicy.FirmwareWait ran out.
+var ErrFirmwareWaitExpired = errors.New("device still updating firmware")
+
+const (
+	defaultRetryDelay    = 200 * time.Millisecond
+	defaultRetryMaxDelay = 5 * time.Second
+)
+
+// RetryPolicy controls how a failed request is retried. Transport errors,
+// HTTP 5xx and response codes 1 (Initializing) and 6 (Time Out) are retried
+// up to Config.Retries times with exponential backoff and jitter. Response
+// code 99 (Firmware Updating) is retried until FirmwareWait elapses, or
+// returned immediately when FirmwareWait is zero.
+//
+// Only getters are retried unless RetryWrites is set: most setters are safe
+// to repeat, but some (volume up/down, toggles, preset moves) are not.
+type RetryPolicy struct {
+	Delay        time.Duration
+	MaxDelay     time.Duration
+	FirmwareWait time.Duration
+	RetryWrites  bool
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	base := p.Delay
+	if base <= 0 {
+		base = defaultRetryDelay
+	}
+	ceiling := p.MaxDelay
+	if ceiling <= 0 {
+		ceiling = defaultRetryMaxDelay
+	}
+	d := base
+	for i := 0; i < attempt && d < ceiling; i++ {
+		d *= 2
+	}
+	d = min(d, ceiling)
+	// Equal jitter: keep half the delay, randomize the rest so that several
+	// clients hitting a booting device do not retry in lockstep.
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// idempotent reports whether a request only reads state. The API uses GET
+// for setters too, so the method name decides.
+func idempotent(method, path string) bool {
+	if method != http.MethodGet {
+		return false
+	}
+	path = strings.TrimRight(path, "/")
+	op := path[strings.LastIndex(path, "/")+1:]
+	return strings.HasPrefix(op, "get")
+}
+
+// retryReason returns why an attempt should be retried, or nil when its
+// outcome is final.
+func retryReason(endpoint string, resp *RawResponse, err error) error {
+	if errors.Is(err, ErrReplayMismatch) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if resp.Status >= 500 {
+		return &HTTPError{Endpoint: endpoint, Status: resp.Status}
+	}
+	if resp.Status >= 400 {
+		return nil
+	}
+	switch code, _ := ResponseCode(resp.Body); code {
+	case 1, 6, 99:
+		return &ResponseError{Endpoint: endpoint, Code: code}
+	}
+	return nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
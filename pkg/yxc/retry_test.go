@@ -0,0 +1,102 @@
+package yxc_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/amannm/yxc/pkg/yxc"
+	"github.com/amannm/yxc/pkg/yxc/mock"
+)
+
+func newMockClient(t *testing.T, policy yxc.RetryPolicy) (*yxc.Client, *mock.Device) {
+	t.Helper()
+	d := mock.New()
+	srv := httptest.NewServer(d)
+	t.Cleanup(func() {
+		srv.Close()
+		_ = d.Close()
+	})
+	policy.Delay = time.Millisecond
+	policy.MaxDelay = 4 * time.Millisecond
+	return yxc.New(yxc.Config{BaseURL: srv.URL + "/YamahaExtendedControl", Retries: 3, Retry: policy}), d
+}
+
+func TestRetryPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("getter retried while initializing", func(t *testing.T) {
+		c, d := newMockClient(t, yxc.RetryPolicy{})
+		d.Fail("main/getStatus", 1, 6)
+		if _, err := c.Zone("main").GetStatus(ctx); err != nil {
+			t.Fatalf("get status: %v", err)
+		}
+		if n := d.Calls("main/getStatus"); n != 3 {
+			t.Fatalf("calls = %d, want 3", n)
+		}
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		c, d := newMockClient(t, yxc.RetryPolicy{})
+		d.Fail("main/getStatus", 1, 1, 1, 1, 1)
+		if _, err := c.Zone("main").GetStatus(ctx); !errors.Is(err, yxc.ErrInitializing) {
+			t.Fatalf("err = %v, want Initializing", err)
+		}
+		if n := d.Calls("main/getStatus"); n != 4 {
+			t.Fatalf("calls = %d, want 4", n)
+		}
+	})
+
+	t.Run("setter not retried by default", func(t *testing.T) {
+		c, d := newMockClient(t, yxc.RetryPolicy{})
+		d.Fail("main/setVolume", 1)
+		if _, err := c.Zone("main").SetVolume(ctx, 40); !errors.Is(err, yxc.ErrInitializing) {
+			t.Fatalf("err = %v, want Initializing", err)
+		}
+		if n := d.Calls("main/setVolume"); n != 1 {
+			t.Fatalf("calls = %d, want 1", n)
+		}
+	})
+
+	t.Run("setter retried when opted in", func(t *testing.T) {
+		c, d := newMockClient(t, yxc.RetryPolicy{RetryWrites: true})
+		d.Fail("main/setVolume", 1)
+		if _, err := c.Zone("main").SetVolume(ctx, 40); err != nil {
+			t.Fatalf("set volume: %v", err)
+		}
+	})
+
+	t.Run("firmware updating aborts", func(t *testing.T) {
+		c, d := newMockClient(t, yxc.RetryPolicy{})
+		d.Fail("main/getStatus", 99)
+		if _, err := c.Zone("main").GetStatus(ctx); !errors.Is(err, yxc.ErrFirmwareUpdating) {
+			t.Fatalf("err = %v, want Firmware Updating", err)
+		}
+		if n := d.Calls("main/getStatus"); n != 1 {
+			t.Fatalf("calls = %d, want 1", n)
+		}
+	})
+
+	t.Run("firmware updating waits", func(t *testing.T) {
+		c, d := newMockClient(t, yxc.RetryPolicy{FirmwareWait: time.Second})
+		d.Fail("main/getStatus", 99, 99, 99, 99, 99, 99)
+		if _, err := c.Zone("main").GetStatus(ctx); err != nil {
+			t.Fatalf("get status: %v", err)
+		}
+	})
+
+	t.Run("firmware wait expires", func(t *testing.T) {
+		c, d := newMockClient(t, yxc.RetryPolicy{FirmwareWait: 10 * time.Millisecond})
+		codes := make([]int, 1000)
+		for i := range codes {
+			codes[i] = 99
+		}
+		d.Fail("main/getStatus", codes...)
+		_, err := c.Zone("main").GetStatus(ctx)
+		if !errors.Is(err, yxc.ErrFirmwareWaitExpired) || !errors.Is(err, yxc.ErrFirmwareUpdating) {
+			t.Fatalf("err = %v, want firmware wait expired", err)
+		}
+	})
+}
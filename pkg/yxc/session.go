@@ -0,0 +1,246 @@
+package yxc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// ErrReplayMismatch is returned by a Replayer for a request the session has
+// no unused recorded exchange for.
+var ErrReplayMismatch = errors.New("replay: no recorded response")
+
+// SessionEntry is one recorded exchange, stored one per line as JSON. Bodies
+// that are JSON are kept inline; anything else is stored as a JSON string.
+type SessionEntry struct {
+	Time        time.Time       `json:"time"`
+	DurationMS  int64           `json:"duration_ms"`
+	Method      string          `json:"method"`
+	Host        string          `json:"host"`
+	Path        string          `json:"path"`
+	Query       string          `json:"query,omitempty"`
+	RequestBody json.RawMessage `json:"request_body,omitempty"`
+	Status      int             `json:"status,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+	Error       string          `json:"error,omitempty"`
+}
+
+func (e *SessionEntry) matches(req *http.Request, body []byte) bool {
+	return e.Method == req.Method &&
+		e.Path == req.URL.Path &&
+		canonicalQuery(e.Query) == canonicalQuery(req.URL.RawQuery) &&
+		bytes.Equal(compactBody(e.RequestBody), compactBody(encodeBody(body)))
+}
+
+func canonicalQuery(raw string) string {
+	q, err := url.ParseQuery(raw)
+	if err != nil {
+		return raw
+	}
+	return q.Encode()
+}
+
+func encodeBody(b []byte) json.RawMessage {
+	if len(b) == 0 {
+		return nil
+	}
+	if json.Valid(b) {
+		return json.RawMessage(b)
+	}
+	out, _ := json.Marshal(string(b))
+	return out
+}
+
+func decodeBody(b json.RawMessage) []byte {
+	var s string
+	if len(b) > 0 && b[0] == '"' && json.Unmarshal(b, &s) == nil {
+		return []byte(s)
+	}
+	return b
+}
+
+func compactBody(b json.RawMessage) []byte {
+	var buf bytes.Buffer
+	if json.Compact(&buf, b) != nil {
+		return b
+	}
+	return buf.Bytes()
+}
+
+// readBody drains r, leaving an equivalent reader in its place.
+func readBody(r *io.ReadCloser) ([]byte, error) {
+	if *r == nil || *r == http.NoBody {
+		return nil, nil
+	}
+	b, err := io.ReadAll(*r)
+	_ = (*r).Close()
+	*r = io.NopCloser(bytes.NewReader(b))
+	return b, err
+}
+
+// Recorder is an http.RoundTripper that appends every exchange made through
+// Next (http.DefaultTransport when nil) to W as a SessionEntry.
+type Recorder struct {
+	Next http.RoundTripper
+	W    io.Writer
+	mu   sync.Mutex
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	next := r.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	start := time.Now()
+	resp, rtErr := next.RoundTrip(req)
+	e := SessionEntry{
+		Time:        start.UTC(),
+		Method:      req.Method,
+		Host:        req.URL.Host,
+		Path:        req.URL.Path,
+		Query:       req.URL.RawQuery,
+		RequestBody: encodeBody(reqBody),
+	}
+	if rtErr != nil {
+		e.Error = rtErr.Error()
+	} else {
+		body, err := readBody(&resp.Body)
+		if err != nil {
+			e.Error = err.Error()
+		}
+		e.Status = resp.StatusCode
+		e.Body = encodeBody(body)
+	}
+	e.DurationMS = time.Since(start).Milliseconds()
+	if err := r.write(&e); err != nil {
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		return nil, fmt.Errorf("record: %w", err)
+	}
+	return resp, rtErr
+}
+
+func (r *Recorder) write(e *SessionEntry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, err = r.W.Write(append(line, '\n'))
+	return err
+}
+
+// ReadSession parses a session written by a Recorder.
+func ReadSession(r io.Reader) ([]SessionEntry, error) {
+	entries := []SessionEntry{}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 16<<20)
+	for n := 1; sc.Scan(); n++ {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var e SessionEntry
+		if err := json.Unmarshal(line, &e); err != nil {
+			return nil, fmt.Errorf("session line %d: %w", n, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, sc.Err()
+}
+
+// Replayer is an http.RoundTripper that answers from recorded entries instead
+// of the network. Each entry is used once, in recorded order, so retries and
+// repeated polls replay as they happened. Entries recorded against the
+// request's host are preferred; the host is otherwise ignored so a session
+// can be replayed against any address.
+type Replayer struct {
+	mu      sync.Mutex
+	entries []SessionEntry
+	used    []bool
+}
+
+func NewReplayer(entries []SessionEntry) *Replayer {
+	return &Replayer{entries: entries, used: make([]bool, len(entries))}
+}
+
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	match := -1
+	for i := range r.entries {
+		if r.used[i] || !r.entries[i].matches(req, body) {
+			continue
+		}
+		if r.entries[i].Host == req.URL.Host {
+			match = i
+			break
+		}
+		if match < 0 {
+			match = i
+		}
+	}
+	if match >= 0 {
+		r.used[match] = true
+	}
+	r.mu.Unlock()
+	if match < 0 {
+		target := req.URL.Path
+		if req.URL.RawQuery != "" {
+			target += "?" + req.URL.RawQuery
+		}
+		return nil, fmt.Errorf("%w for %s %s", ErrReplayMismatch, req.Method, target)
+	}
+	e := r.entries[match]
+	if e.Error != "" {
+		return nil, errors.New(e.Error)
+	}
+	respBody := decodeBody(e.Body)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.Status, http.StatusText(e.Status)),
+		StatusCode:    e.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{contentTypeOf(respBody)}},
+		Body:          io.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
+
+// Remaining reports how many recorded entries have not been replayed.
+func (r *Replayer) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, u := range r.used {
+		if !u {
+			n++
+		}
+	}
+	return n
+}
+
+func contentTypeOf(body []byte) string {
+	if json.Valid(body) {
+		return "application/json"
+	}
+	return "text/plain; charset=utf-8"
+}
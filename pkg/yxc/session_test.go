@@ -0,0 +1,58 @@
+package yxc_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amannm/yxc/pkg/yxc"
+	"github.com/amannm/yxc/pkg/yxc/mock"
+)
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	d := mock.New()
+	defer d.Close()
+	srv := httptest.NewServer(d)
+
+	var session bytes.Buffer
+	rec := yxc.New(yxc.Config{BaseURL: srv.URL + "/YamahaExtendedControl"})
+	rec.HTTPClient = &http.Client{Transport: &yxc.Recorder{W: &session}}
+	if _, err := rec.Zone("main").SetVolume(ctx, 55); err != nil {
+		t.Fatalf("set volume: %v", err)
+	}
+	if _, err := rec.Zone("main").GetStatus(ctx); err != nil {
+		t.Fatalf("get status: %v", err)
+	}
+	srv.Close()
+
+	entries, err := yxc.ReadSession(&session)
+	if err != nil {
+		t.Fatalf("read session: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("recorded %d entries, want 2", len(entries))
+	}
+	replayer := yxc.NewReplayer(entries)
+	c := yxc.New(yxc.Config{Host: "192.0.2.1"})
+	c.HTTPClient = &http.Client{Transport: replayer}
+	if _, err := c.Zone("main").SetVolume(ctx, 55); err != nil {
+		t.Fatalf("replay set volume: %v", err)
+	}
+	st, err := c.Zone("main").GetStatus(ctx)
+	if err != nil {
+		t.Fatalf("replay get status: %v", err)
+	}
+	if st.Volume != 55 {
+		t.Fatalf("replayed volume = %d, want 55", st.Volume)
+	}
+	if _, err := c.Zone("main").GetStatus(ctx); !errors.Is(err, yxc.ErrReplayMismatch) {
+		t.Fatalf("second get status: err = %v, want replay mismatch", err)
+	}
+	if n := replayer.Remaining(); n != 0 {
+		t.Fatalf("remaining = %d, want 0", n)
+	}
+}
@@ -0,0 +1,274 @@
+package yxc
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// State caches the last known status of every zone and playback source.
+// After Load, Apply refetches only the parts an event flags as changed and
+// patches plain values (volume, mute, play_time, ...) in place.
+type State struct {
+	c        *Client
+	mu       sync.Mutex
+	deviceID string
+	updated  time.Time
+	fetchers map[string]func(context.Context) ([]byte, error)
+	parts    map[string]map[string]any
+}
+
+func (c *Client) NewState() *State {
+	return &State{c: c, parts: map[string]map[string]any{}}
+}
+
+func fetchRaw[T interface{ Raw() []byte }](v T, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	return v.Raw(), nil
+}
+
+// Load reads getFeatures to learn which zones and sources exist, then
+// fetches all of them.
+func (s *State) Load(ctx context.Context) error {
+	info, err := s.c.System().GetDeviceInfo(ctx)
+	if err != nil {
+		return err
+	}
+	features, err := s.c.System().GetFeatures(ctx)
+	if err != nil {
+		return err
+	}
+	fetchers := map[string]func(context.Context) ([]byte, error){
+		"system.func_status": func(ctx context.Context) ([]byte, error) {
+			return fetchRaw(s.c.System().GetFuncStatus(ctx))
+		},
+		"system.location": func(ctx context.Context) ([]byte, error) {
+			return fetchRaw(s.c.System().GetLocationInfo(ctx))
+		},
+	}
+	for _, z := range features.Zone {
+		zone := s.c.Zone(z.ID)
+		fetchers["zones."+z.ID] = func(ctx context.Context) ([]byte, error) {
+			return fetchRaw(zone.GetStatus(ctx))
+		}
+	}
+	sources := map[string]bool{}
+	for _, in := range features.System.InputList {
+		sources[in.PlayInfoType] = true
+	}
+	if sources["tuner"] {
+		fetchers["tuner"] = func(ctx context.Context) ([]byte, error) {
+			return fetchRaw(s.c.Tuner().GetPlayInfo(ctx))
+		}
+	}
+	if sources["netusb"] {
+		fetchers["netusb"] = func(ctx context.Context) ([]byte, error) {
+			return fetchRaw(s.c.NetUSB().GetPlayInfo(ctx))
+		}
+	}
+	if sources["cd"] {
+		fetchers["cd"] = func(ctx context.Context) ([]byte, error) {
+			return fetchRaw(s.c.CD().GetPlayInfo(ctx))
+		}
+	}
+	if features.Distribution.Version > 0 {
+		fetchers["dist"] = func(ctx context.Context) ([]byte, error) {
+			return fetchRaw(s.c.Dist().GetDistributionInfo(ctx))
+		}
+	}
+	if len(features.Clock.FuncList) > 0 {
+		fetchers["clock"] = func(ctx context.Context) ([]byte, error) {
+			return fetchRaw(s.c.Clock().GetSettings(ctx))
+		}
+	}
+	s.mu.Lock()
+	s.deviceID = info.DeviceID
+	s.fetchers = fetchers
+	s.mu.Unlock()
+	keys := make([]string, 0, len(fetchers))
+	for k := range fetchers {
+		keys = append(keys, k)
+	}
+	_, err = s.refresh(ctx, keys)
+	return err
+}
+
+// refresh refetches the parts named by keys and returns those whose content
+// changed.
+func (s *State) refresh(ctx context.Context, keys []string) ([]string, error) {
+	changed := []string{}
+	for _, key := range keys {
+		s.mu.Lock()
+		fetch := s.fetchers[key]
+		s.mu.Unlock()
+		if fetch == nil {
+			continue
+		}
+		body, err := fetch(ctx)
+		if err != nil {
+			return changed, err
+		}
+		var part map[string]any
+		if err := json.Unmarshal(body, &part); err != nil {
+			return changed, err
+		}
+		delete(part, "response_code")
+		s.mu.Lock()
+		if old, ok := s.parts[key]; !ok || !reflect.DeepEqual(old, part) {
+			s.parts[key] = part
+			changed = append(changed, key)
+		}
+		s.updated = time.Now()
+		s.mu.Unlock()
+	}
+	return changed, nil
+}
+
+// Apply updates the cache from one event and returns the parts whose
+// content changed; an event repeating a value already cached changes
+// nothing.
+func (s *State) Apply(ctx context.Context, e *Event) ([]string, error) {
+	refetch := map[string]bool{}
+	patches := map[string]map[string]json.RawMessage{}
+	patch := func(part string, section map[string]json.RawMessage, keys ...string) {
+		for _, k := range keys {
+			if v, ok := section[k]; ok {
+				if patches[part] == nil {
+					patches[part] = map[string]json.RawMessage{}
+				}
+				patches[part][k] = v
+			}
+		}
+	}
+	for name, section := range e.Sections {
+		switch name {
+		case "system":
+			if e.Flag(name, "func_status_updated") {
+				refetch["system.func_status"] = true
+			}
+			if e.Flag(name, "location_info_updated") {
+				refetch["system.location"] = true
+			}
+		case "main", "zone2", "zone3", "zone4":
+			if e.Flag(name, "status_updated") {
+				refetch["zones."+name] = true
+			} else {
+				patch("zones."+name, section, "power", "input", "volume", "mute")
+			}
+		case "tuner", "netusb", "cd":
+			if e.Flag(name, "play_info_updated") {
+				refetch[name] = true
+			} else {
+				patch(name, section, "play_time", "device_status")
+			}
+		case "dist":
+			if e.Flag(name, "dist_info_updated") {
+				refetch["dist"] = true
+			}
+		case "clock":
+			if e.Flag(name, "settings_updated") {
+				refetch["clock"] = true
+			}
+		}
+	}
+	changed := []string{}
+	s.mu.Lock()
+	for key, values := range patches {
+		part, ok := s.parts[key]
+		if !ok || refetch[key] {
+			continue
+		}
+		patched := false
+		for k, raw := range values {
+			var v any
+			if json.Unmarshal(raw, &v) == nil && !reflect.DeepEqual(part[k], v) {
+				part[k] = v
+				patched = true
+			}
+		}
+		if patched {
+			changed = append(changed, key)
+		}
+		s.updated = time.Now()
+	}
+	keys := []string{}
+	for key := range refetch {
+		if s.fetchers[key] != nil {
+			keys = append(keys, key)
+		}
+	}
+	s.mu.Unlock()
+	refreshed, err := s.refresh(ctx, keys)
+	changed = append(changed, refreshed...)
+	sort.Strings(changed)
+	return changed, err
+}
+
+// Snapshot merges the cached parts into one document; dotted part keys
+// become nested objects.
+func (s *State) Snapshot() map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := map[string]any{
+		"device_id": s.deviceID,
+		"updated":   s.updated.Format(time.RFC3339),
+	}
+	for key, part := range s.parts {
+		node := out
+		path := strings.Split(key, ".")
+		for _, p := range path[:len(path)-1] {
+			child, ok := node[p].(map[string]any)
+			if !ok {
+				child = map[string]any{}
+				node[p] = child
+			}
+			node = child
+		}
+		copied := make(map[string]any, len(part))
+		for k, v := range part {
+			copied[k] = v
+		}
+		node[path[len(path)-1]] = copied
+	}
+	return out
+}
+
+// Follow subscribes to events, loads the state and keeps it current until
+// ctx is done. onChange is called once with no parts after loading, then
+// after every event that changed something. Subscribing before loading
+// means events sent while loading wait in l and are applied afterwards,
+// rather than being lost.
+func (s *State) Follow(ctx context.Context, l *EventListener, appName string, renew time.Duration, onChange func(changed []string) error) error {
+	renew, err := renewInterval(renew)
+	if err != nil {
+		return err
+	}
+	if err := s.c.Subscribe(ctx, appName, l.Port()); err != nil {
+		return err
+	}
+	if err := s.Load(ctx); err != nil {
+		return err
+	}
+	if err := onChange(nil); err != nil {
+		return err
+	}
+	return s.c.watch(ctx, l, appName, renew, func(e *Event) error {
+		if e.DeviceID != "" && s.deviceID != "" && e.DeviceID != s.deviceID {
+			return nil
+		}
+		changed, err := s.Apply(ctx, e)
+		if err != nil {
+			return err
+		}
+		if len(changed) == 0 {
+			return nil
+		}
+		return onChange(changed)
+	})
+}
@@ -0,0 +1,270 @@
+package yxc
+
+import (
+	"context"
+	"strconv"
+)
+
+type SystemService struct {
+	c *Client
+}
+
+func (c *Client) System() *SystemService {
+	return &SystemService{c: c}
+}
+
+type DeviceInfo struct {
+	Response
+	ModelName           string  `json:"model_name"`
+	Destination         string  `json:"destination"`
+	DeviceID            string  `json:"device_id"`
+	SystemVersion       float64 `json:"system_version"`
+	APIVersion          float64 `json:"api_version"`
+	NetmoduleVersion    string  `json:"netmodule_version"`
+	NetmoduleChecksum   string  `json:"netmodule_checksum"`
+	SerialNumber        string  `json:"serial_number"`
+	CategoryCode        int     `json:"category_code"`
+	NetmoduleGeneration int     `json:"netmodule_generation"`
+}
+
+type RangeStep struct {
+	ID   string  `json:"id"`
+	Min  float64 `json:"min"`
+	Max  float64 `json:"max"`
+	Step float64 `json:"step"`
+}
+
+type InputFeature struct {
+	ID                 string `json:"id"`
+	DistributionEnable bool   `json:"distribution_enable"`
+	RenameEnable       bool   `json:"rename_enable"`
+	AccountEnable      bool   `json:"account_enable"`
+	PlayInfoType       string `json:"play_info_type"`
+}
+
+type ZoneFeature struct {
+	ID                   string      `json:"id"`
+	FuncList             []string    `json:"func_list"`
+	InputList            []string    `json:"input_list"`
+	SoundProgramList     []string    `json:"sound_program_list"`
+	RangeStep            []RangeStep `json:"range_step"`
+	SceneNum             int         `json:"scene_num"`
+	CursorList           []string    `json:"cursor_list"`
+	MenuList             []string    `json:"menu_list"`
+	ActualVolumeModeList []string    `json:"actual_volume_mode_list"`
+	SurrDecoderTypeList  []string    `json:"surr_decoder_type_list"`
+	LinkControlList      []string    `json:"link_control_list"`
+	LinkAudioDelayList   []string    `json:"link_audio_delay_list"`
+	LinkAudioQualityList []string    `json:"link_audio_quality_list"`
+	ToneControlModeList  []string    `json:"tone_control_mode_list"`
+	EqualizerModeList    []string    `json:"equalizer_mode_list"`
+}
+
+// Range returns the range_step entry with the given id.
+func (z *ZoneFeature) Range(id string) (RangeStep, bool) {
+	return findRange(z.RangeStep, id)
+}
+
+func findRange(ranges []RangeStep, id string) (RangeStep, bool) {
+	for _, r := range ranges {
+		if r.ID == id {
+			return r, true
+		}
+	}
+	return RangeStep{}, false
+}
+
+type Features struct {
+	Response
+	System struct {
+		FuncList  []string       `json:"func_list"`
+		ZoneNum   int            `json:"zone_num"`
+		InputList []InputFeature `json:"input_list"`
+	} `json:"system"`
+	Zone  []ZoneFeature `json:"zone"`
+	Tuner struct {
+		FuncList  []string    `json:"func_list"`
+		RangeStep []RangeStep `json:"range_step"`
+		Preset    struct {
+			Type string `json:"type"`
+			Num  int    `json:"num"`
+		} `json:"preset"`
+	} `json:"tuner"`
+	Netusb struct {
+		FuncList []string `json:"func_list"`
+		Preset   struct {
+			Num int `json:"num"`
+		} `json:"preset"`
+		RecentInfo struct {
+			Num int `json:"num"`
+		} `json:"recent_info"`
+	} `json:"netusb"`
+	Distribution struct {
+		Version          float64  `json:"version"`
+		CompatibleClient []int    `json:"compatible_client"`
+		ClientMax        int      `json:"client_max"`
+		ServerZoneList   []string `json:"server_zone_list"`
+	} `json:"distribution"`
+	Clock struct {
+		FuncList []string `json:"func_list"`
+	} `json:"clock"`
+}
+
+// ZoneFeature returns the features of the zone with the given id.
+func (f *Features) ZoneFeature(id string) (*ZoneFeature, bool) {
+	for i := range f.Zone {
+		if f.Zone[i].ID == id {
+			return &f.Zone[i], true
+		}
+	}
+	return nil, false
+}
+
+// TunerRange returns the frequency range of a tuner band.
+func (f *Features) TunerRange(band string) (RangeStep, bool) {
+	return findRange(f.Tuner.RangeStep, band)
+}
+
+type NetworkStatus struct {
+	Response
+	NetworkName    string `json:"network_name"`
+	Connection     string `json:"connection"`
+	DHCP           bool   `json:"dhcp"`
+	IPAddress      string `json:"ip_address"`
+	SubnetMask     string `json:"subnet_mask"`
+	DefaultGateway string `json:"default_gateway"`
+	DNSServer1     string `json:"dns_server_1"`
+	DNSServer2     string `json:"dns_server_2"`
+	MACAddress     struct {
+		WiredLAN       string `json:"wired_lan"`
+		WirelessLAN    string `json:"wireless_lan"`
+		WirelessDirect string `json:"wireless_direct"`
+	} `json:"mac_address"`
+}
+
+type FuncStatus struct {
+	Response
+	AutoPowerStandby bool `json:"auto_power_standby"`
+	IRSensor         bool `json:"ir_sensor"`
+	SpeakerA         bool `json:"speaker_a"`
+	SpeakerB         bool `json:"speaker_b"`
+	Headphone        bool `json:"headphone"`
+	Dimmer           int  `json:"dimmer"`
+	ZoneBVolumeSync  bool `json:"zone_b_volume_sync"`
+	HDMIOut1         bool `json:"hdmi_out_1"`
+	HDMIOut2         bool `json:"hdmi_out_2"`
+	HDMIOut3         bool `json:"hdmi_out_3"`
+	AutoPlay         bool `json:"auto_play"`
+	SpeakerPattern   int  `json:"speaker_pattern"`
+	PartyMode        bool `json:"party_mode"`
+}
+
+type LocationInfo struct {
+	Response
+	ID       string          `json:"id"`
+	Name     string          `json:"name"`
+	ZoneList map[string]bool `json:"zone_list"`
+}
+
+type NameText struct {
+	Response
+	ID   string `json:"id"`
+	Text string `json:"text"`
+}
+
+func (s *SystemService) GetDeviceInfo(ctx context.Context) (*DeviceInfo, error) {
+	out := &DeviceInfo{}
+	return out, s.c.get(ctx, "system/getDeviceInfo", nil, out)
+}
+
+func (s *SystemService) GetFeatures(ctx context.Context) (*Features, error) {
+	out := &Features{}
+	return out, s.c.get(ctx, "system/getFeatures", nil, out)
+}
+
+func (s *SystemService) GetNetworkStatus(ctx context.Context) (*NetworkStatus, error) {
+	out := &NetworkStatus{}
+	return out, s.c.get(ctx, "system/getNetworkStatus", nil, out)
+}
+
+func (s *SystemService) GetFuncStatus(ctx context.Context) (*FuncStatus, error) {
+	out := &FuncStatus{}
+	return out, s.c.get(ctx, "system/getFuncStatus", nil, out)
+}
+
+func (s *SystemService) GetLocationInfo(ctx context.Context) (*LocationInfo, error) {
+	out := &LocationInfo{}
+	return out, s.c.get(ctx, "system/getLocationInfo", nil, out)
+}
+
+func (s *SystemService) GetNameText(ctx context.Context, id string) (*NameText, error) {
+	out := &NameText{}
+	return out, s.c.get(ctx, "system/getNameText", setQuery("id", id), out)
+}
+
+func (s *SystemService) SetNameText(ctx context.Context, id, text string) (*Response, error) {
+	q := setQuery("id", id)
+	q.Set("text", text)
+	out := &Response{}
+	return out, s.c.get(ctx, "system/setNameText", q, out)
+}
+
+func (s *SystemService) SetSpeakerA(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, s.c.get(ctx, "system/setSpeakerA", enableQuery(enable), out)
+}
+
+func (s *SystemService) SetSpeakerB(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, s.c.get(ctx, "system/setSpeakerB", enableQuery(enable), out)
+}
+
+func (s *SystemService) SetDimmer(ctx context.Context, value int) (*Response, error) {
+	out := &Response{}
+	return out, s.c.get(ctx, "system/setDimmer", setQuery("value", strconv.Itoa(value)), out)
+}
+
+func (s *SystemService) SetZoneBVolumeSync(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, s.c.get(ctx, "system/setZoneBVolumeSync", enableQuery(enable), out)
+}
+
+func (s *SystemService) SetHdmiOut1(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, s.c.get(ctx, "system/setHdmiOut1", enableQuery(enable), out)
+}
+
+func (s *SystemService) SetHdmiOut2(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, s.c.get(ctx, "system/setHdmiOut2", enableQuery(enable), out)
+}
+
+func (s *SystemService) SendIrCode(ctx context.Context, code string) (*Response, error) {
+	out := &Response{}
+	return out, s.c.get(ctx, "system/sendIrCode", setQuery("code", code), out)
+}
+
+func (s *SystemService) SetAutoPlay(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, s.c.get(ctx, "system/setAutoPlay", enableQuery(enable), out)
+}
+
+func (s *SystemService) SetSpeakerPattern(ctx context.Context, num int) (*Response, error) {
+	out := &Response{}
+	return out, s.c.get(ctx, "system/setSpeakerPattern", setQuery("num", strconv.Itoa(num)), out)
+}
+
+func (s *SystemService) SetPartyMode(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, s.c.get(ctx, "system/setPartyMode", enableQuery(enable), out)
+}
+
+func (s *SystemService) RequestNetworkReboot(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, s.c.get(ctx, "system/requestNetworkReboot", nil, out)
+}
+
+func (s *SystemService) RequestSystemReboot(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, s.c.get(ctx, "system/requestSystemReboot", nil, out)
+}
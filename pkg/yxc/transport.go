@@ -0,0 +1,62 @@
+package yxc
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+var (
+	transportsMu sync.Mutex
+	transports   = map[string]*http.Client{}
+)
+
+// NewHTTPClient returns a client tuned for talking to a handful of devices
+// on the local network: short dial and header timeouts, a small pool of
+// kept-alive connections per host and HTTP/1.1 only, which is all devices
+// speak. With an empty proxy it honors HTTP_PROXY, HTTPS_PROXY and NO_PROXY.
+func NewHTTPClient(proxy string) (*http.Client, error) {
+	proxyFunc := http.ProxyFromEnvironment
+	if proxy != "" {
+		u, err := url.Parse(proxy)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("invalid proxy url %q", proxy)
+		}
+		proxyFunc = http.ProxyURL(u)
+	}
+	dialer := &net.Dialer{Timeout: 5 * time.Second, KeepAlive: 30 * time.Second}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 proxyFunc,
+			DialContext:           dialer.DialContext,
+			MaxIdleConns:          32,
+			MaxIdleConnsPerHost:   4,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   5 * time.Second,
+			ExpectContinueTimeout: time.Second,
+			ForceAttemptHTTP2:     false,
+		},
+	}, nil
+}
+
+// httpClient returns Client.HTTPClient or a process-wide client for the
+// configured proxy, so every Client shares kept-alive connections.
+func (c *Client) httpClient() (*http.Client, error) {
+	if c.HTTPClient != nil {
+		return c.HTTPClient, nil
+	}
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+	if hc, ok := transports[c.Config.Proxy]; ok {
+		return hc, nil
+	}
+	hc, err := NewHTTPClient(c.Config.Proxy)
+	if err != nil {
+		return nil, err
+	}
+	transports[c.Config.Proxy] = hc
+	return hc, nil
+}
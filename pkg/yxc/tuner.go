@@ -0,0 +1,167 @@
+package yxc
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+type TunerService struct {
+	c *Client
+}
+
+func (c *Client) Tuner() *TunerService {
+	return &TunerService{c: c}
+}
+
+type TunerPreset struct {
+	Band      string `json:"band"`
+	Number    int    `json:"number"`
+	HDProgram int    `json:"hd_program"`
+	Text      string `json:"text"`
+}
+
+type TunerPresetInfo struct {
+	Response
+	PresetInfo []TunerPreset `json:"preset_info"`
+	FuncList   []string      `json:"func_list"`
+}
+
+type TunerPlayInfo struct {
+	Response
+	Band       string `json:"band"`
+	AutoScan   bool   `json:"auto_scan"`
+	AutoPreset bool   `json:"auto_preset"`
+	AM         struct {
+		Preset int  `json:"preset"`
+		Freq   int  `json:"freq"`
+		Tuned  bool `json:"tuned"`
+	} `json:"am"`
+	FM struct {
+		Preset    int    `json:"preset"`
+		Freq      int    `json:"freq"`
+		Tuned     bool   `json:"tuned"`
+		AudioMode string `json:"audio_mode"`
+	} `json:"fm"`
+	RDS struct {
+		ProgramType    string `json:"program_type"`
+		ProgramService string `json:"program_service"`
+		RadioTextA     string `json:"radio_text_a"`
+		RadioTextB     string `json:"radio_text_b"`
+	} `json:"rds"`
+	DAB struct {
+		Preset        int    `json:"preset"`
+		ID            int    `json:"id"`
+		Status        string `json:"status"`
+		Freq          int    `json:"freq"`
+		Category      string `json:"category"`
+		AudioMode     string `json:"audio_mode"`
+		BitRate       int    `json:"bit_rate"`
+		Quality       int    `json:"quality"`
+		TuneAid       int    `json:"tune_aid"`
+		OffAir        bool   `json:"off_air"`
+		DABPlus       bool   `json:"dab_plus"`
+		ProgramType   string `json:"program_type"`
+		ChLabel       string `json:"ch_label"`
+		ServiceLabel  string `json:"service_label"`
+		DLS           string `json:"dls"`
+		EnsembleLabel string `json:"ensemble_label"`
+	} `json:"dab"`
+}
+
+type FreqRequest struct {
+	Band   string
+	Tuning string
+	Num    *int
+}
+
+func (t *TunerService) GetPresetInfo(ctx context.Context, band string) (*TunerPresetInfo, error) {
+	out := &TunerPresetInfo{}
+	return out, t.c.get(ctx, "tuner/getPresetInfo", setQuery("band", band), out)
+}
+
+func (t *TunerService) GetPlayInfo(ctx context.Context) (*TunerPlayInfo, error) {
+	out := &TunerPlayInfo{}
+	return out, t.c.get(ctx, "tuner/getPlayInfo", nil, out)
+}
+
+func (t *TunerService) SetBand(ctx context.Context, band string) (*Response, error) {
+	out := &Response{}
+	return out, t.c.get(ctx, "tuner/setBand", setQuery("band", band), out)
+}
+
+func (t *TunerService) SetFreq(ctx context.Context, r FreqRequest) (*Response, error) {
+	q := setQuery("band", r.Band)
+	q.Set("tuning", r.Tuning)
+	if r.Num != nil {
+		q.Set("num", strconv.Itoa(*r.Num))
+	}
+	out := &Response{}
+	return out, t.c.get(ctx, "tuner/setFreq", q, out)
+}
+
+func (t *TunerService) RecallPreset(ctx context.Context, zone, band string, num int) (*Response, error) {
+	q := setQuery("zone", zoneOrDefault(zone))
+	q.Set("band", band)
+	q.Set("num", strconv.Itoa(num))
+	out := &Response{}
+	return out, t.c.get(ctx, "tuner/recallPreset", q, out)
+}
+
+func (t *TunerService) SwitchPreset(ctx context.Context, dir string) (*Response, error) {
+	out := &Response{}
+	return out, t.c.get(ctx, "tuner/switchPreset", setQuery("dir", dir), out)
+}
+
+func (t *TunerService) StorePreset(ctx context.Context, num int) (*Response, error) {
+	out := &Response{}
+	return out, t.c.get(ctx, "tuner/storePreset", setQuery("num", strconv.Itoa(num)), out)
+}
+
+func (t *TunerService) ClearPreset(ctx context.Context, band string, num int) (*Response, error) {
+	q := setQuery("band", band)
+	q.Set("num", strconv.Itoa(num))
+	out := &Response{}
+	return out, t.c.get(ctx, "tuner/clearPreset", q, out)
+}
+
+func (t *TunerService) MovePreset(ctx context.Context, band string, from, to int) (*Response, error) {
+	q := setQuery("band", band)
+	q.Set("from", strconv.Itoa(from))
+	q.Set("to", strconv.Itoa(to))
+	out := &Response{}
+	return out, t.c.get(ctx, "tuner/movePreset", q, out)
+}
+
+func (t *TunerService) StartAutoPreset(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, t.c.get(ctx, "tuner/startAutoPreset", setQuery("band", "fm"), out)
+}
+
+func (t *TunerService) CancelAutoPreset(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, t.c.get(ctx, "tuner/cancelAutoPreset", nil, out)
+}
+
+func (t *TunerService) StartDabInitialScan(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, t.c.get(ctx, "tuner/startDabInitialScan", nil, out)
+}
+
+func (t *TunerService) CancelDabInitialScan(ctx context.Context) (*Response, error) {
+	out := &Response{}
+	return out, t.c.get(ctx, "tuner/cancelDabInitialScan", nil, out)
+}
+
+func (t *TunerService) SetDabService(ctx context.Context, dir string) (*Response, error) {
+	out := &Response{}
+	return out, t.c.get(ctx, "tuner/setDabService", setQuery("dir", dir), out)
+}
+
+func zoneOrDefault(zone string) string {
+	zone = strings.TrimSpace(zone)
+	if zone == "" {
+		return "main"
+	}
+	return zone
+}
@@ -0,0 +1,312 @@
+package yxc
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type ZoneService struct {
+	c    *Client
+	zone string
+}
+
+func (c *Client) Zone(zone string) *ZoneService {
+	zone = strings.TrimSpace(zone)
+	if zone == "" {
+		zone = "main"
+	}
+	return &ZoneService{c: c, zone: zone}
+}
+
+func (z *ZoneService) ID() string {
+	return z.zone
+}
+
+func (z *ZoneService) path(p string) string {
+	return z.zone + "/" + p
+}
+
+type ActualVolume struct {
+	Mode  string  `json:"mode"`
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+type ZoneStatus struct {
+	Response
+	Power              string        `json:"power"`
+	Sleep              int           `json:"sleep"`
+	Volume             int           `json:"volume"`
+	Mute               bool          `json:"mute"`
+	MaxVolume          int           `json:"max_volume"`
+	Input              string        `json:"input"`
+	InputText          string        `json:"input_text"`
+	DistributionEnable bool          `json:"distribution_enable"`
+	SoundProgram       string        `json:"sound_program"`
+	SurrDecoderType    string        `json:"surr_decoder_type"`
+	Surround3D         bool          `json:"surround_3d"`
+	Direct             bool          `json:"direct"`
+	PureDirect         bool          `json:"pure_direct"`
+	Enhancer           bool          `json:"enhancer"`
+	ToneControl        ToneControl   `json:"tone_control"`
+	Equalizer          Equalizer     `json:"equalizer"`
+	Balance            int           `json:"balance"`
+	DialogueLevel      int           `json:"dialogue_level"`
+	DialogueLift       int           `json:"dialogue_lift"`
+	ClearVoice         bool          `json:"clear_voice"`
+	SubwooferVolume    int           `json:"subwoofer_volume"`
+	BassExtension      bool          `json:"bass_extension"`
+	LinkControl        string        `json:"link_control"`
+	LinkAudioDelay     string        `json:"link_audio_delay"`
+	LinkAudioQuality   string        `json:"link_audio_quality"`
+	DisableFlags       int           `json:"disable_flags"`
+	ActualVolume       *ActualVolume `json:"actual_volume,omitempty"`
+}
+
+type ToneControl struct {
+	Mode   string `json:"mode"`
+	Bass   int    `json:"bass"`
+	Treble int    `json:"treble"`
+}
+
+type Equalizer struct {
+	Mode string `json:"mode"`
+	Low  int    `json:"low"`
+	Mid  int    `json:"mid"`
+	High int    `json:"high"`
+}
+
+type SoundProgramList struct {
+	Response
+	SoundProgramList []string `json:"sound_program_list"`
+}
+
+type SignalInfo struct {
+	Response
+	Audio struct {
+		Error  int    `json:"error"`
+		Format string `json:"format"`
+		FS     string `json:"fs"`
+	} `json:"audio"`
+}
+
+type ToneControlRequest struct {
+	Mode   string
+	Bass   *int
+	Treble *int
+}
+
+type EqualizerRequest struct {
+	Mode string
+	Low  *int
+	Mid  *int
+	High *int
+}
+
+type ActualVolumeRequest struct {
+	Mode  string
+	Value *float64
+}
+
+func (z *ZoneService) GetStatus(ctx context.Context) (*ZoneStatus, error) {
+	out := &ZoneStatus{}
+	return out, z.c.get(ctx, z.path("getStatus"), nil, out)
+}
+
+func (z *ZoneService) GetSoundProgramList(ctx context.Context) (*SoundProgramList, error) {
+	out := &SoundProgramList{}
+	return out, z.c.get(ctx, z.path("getSoundProgramList"), nil, out)
+}
+
+func (z *ZoneService) GetSignalInfo(ctx context.Context) (*SignalInfo, error) {
+	out := &SignalInfo{}
+	return out, z.c.get(ctx, z.path("getSignalInfo"), nil, out)
+}
+
+func (z *ZoneService) SetPower(ctx context.Context, power string) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setPower"), setQuery("power", power), out)
+}
+
+func (z *ZoneService) SetSleep(ctx context.Context, minutes int) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setSleep"), setQuery("sleep", strconv.Itoa(minutes)), out)
+}
+
+func (z *ZoneService) SetVolume(ctx context.Context, volume int) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setVolume"), setQuery("volume", strconv.Itoa(volume)), out)
+}
+
+func (z *ZoneService) VolumeUp(ctx context.Context, step int) (*Response, error) {
+	return z.stepVolume(ctx, "up", step)
+}
+
+func (z *ZoneService) VolumeDown(ctx context.Context, step int) (*Response, error) {
+	return z.stepVolume(ctx, "down", step)
+}
+
+func (z *ZoneService) stepVolume(ctx context.Context, dir string, step int) (*Response, error) {
+	q := setQuery("volume", dir)
+	q.Set("step", strconv.Itoa(step))
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setVolume"), q, out)
+}
+
+func (z *ZoneService) SetMute(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setMute"), enableQuery(enable), out)
+}
+
+func (z *ZoneService) SetInput(ctx context.Context, input, mode string) (*Response, error) {
+	q := setQuery("input", input)
+	if strings.TrimSpace(mode) != "" {
+		q.Set("mode", mode)
+	}
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setInput"), q, out)
+}
+
+func (z *ZoneService) SetSoundProgram(ctx context.Context, program string) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setSoundProgram"), setQuery("program", program), out)
+}
+
+func (z *ZoneService) Set3dSurround(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("set3dSurround"), enableQuery(enable), out)
+}
+
+func (z *ZoneService) SetDirect(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setDirect"), enableQuery(enable), out)
+}
+
+func (z *ZoneService) SetPureDirect(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setPureDirect"), enableQuery(enable), out)
+}
+
+func (z *ZoneService) SetEnhancer(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setEnhancer"), enableQuery(enable), out)
+}
+
+func (z *ZoneService) SetToneControl(ctx context.Context, r ToneControlRequest) (*Response, error) {
+	q := url.Values{}
+	if strings.TrimSpace(r.Mode) != "" {
+		q.Set("mode", r.Mode)
+	}
+	if r.Bass != nil {
+		q.Set("bass", strconv.Itoa(*r.Bass))
+	}
+	if r.Treble != nil {
+		q.Set("treble", strconv.Itoa(*r.Treble))
+	}
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setToneControl"), q, out)
+}
+
+func (z *ZoneService) SetEqualizer(ctx context.Context, r EqualizerRequest) (*Response, error) {
+	q := url.Values{}
+	if strings.TrimSpace(r.Mode) != "" {
+		q.Set("mode", r.Mode)
+	}
+	if r.Low != nil {
+		q.Set("low", strconv.Itoa(*r.Low))
+	}
+	if r.Mid != nil {
+		q.Set("mid", strconv.Itoa(*r.Mid))
+	}
+	if r.High != nil {
+		q.Set("high", strconv.Itoa(*r.High))
+	}
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setEqualizer"), q, out)
+}
+
+func (z *ZoneService) SetBalance(ctx context.Context, value int) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setBalance"), setQuery("value", strconv.Itoa(value)), out)
+}
+
+func (z *ZoneService) SetDialogueLevel(ctx context.Context, value int) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setDialogueLevel"), setQuery("value", strconv.Itoa(value)), out)
+}
+
+func (z *ZoneService) SetDialogueLift(ctx context.Context, value int) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setDialogueLift"), setQuery("value", strconv.Itoa(value)), out)
+}
+
+func (z *ZoneService) SetClearVoice(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setClearVoice"), enableQuery(enable), out)
+}
+
+func (z *ZoneService) SetSubwooferVolume(ctx context.Context, volume int) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setSubwooferVolume"), setQuery("volume", strconv.Itoa(volume)), out)
+}
+
+func (z *ZoneService) SetBassExtension(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setBassExtension"), enableQuery(enable), out)
+}
+
+func (z *ZoneService) PrepareInputChange(ctx context.Context, input string) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("prepareInputChange"), setQuery("input", input), out)
+}
+
+func (z *ZoneService) RecallScene(ctx context.Context, num int) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("recallScene"), setQuery("num", strconv.Itoa(num)), out)
+}
+
+func (z *ZoneService) SetContentsDisplay(ctx context.Context, enable bool) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setContentsDisplay"), enableQuery(enable), out)
+}
+
+func (z *ZoneService) ControlCursor(ctx context.Context, cursor string) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("controlCursor"), setQuery("cursor", cursor), out)
+}
+
+func (z *ZoneService) ExecuteMenu(ctx context.Context, menu string) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("executeMenu"), setQuery("menu", menu), out)
+}
+
+func (z *ZoneService) SetActualVolume(ctx context.Context, r ActualVolumeRequest) (*Response, error) {
+	q := setQuery("mode", r.Mode)
+	if r.Value != nil {
+		q.Set("value", strconv.FormatFloat(*r.Value, 'f', -1, 64))
+	}
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setActualVolume"), q, out)
+}
+
+func (z *ZoneService) SetSurroundDecoderType(ctx context.Context, typ string) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setSurroundDecoderType"), setQuery("type", typ), out)
+}
+
+func (z *ZoneService) SetLinkControl(ctx context.Context, control string) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setLinkControl"), setQuery("control", control), out)
+}
+
+func (z *ZoneService) SetLinkAudioDelay(ctx context.Context, delay string) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setLinkAudioDelay"), setQuery("delay", delay), out)
+}
+
+func (z *ZoneService) SetLinkAudioQuality(ctx context.Context, quality string) (*Response, error) {
+	out := &Response{}
+	return out, z.c.get(ctx, z.path("setLinkAudioQuality"), setQuery("quality", quality), out)
+}
@@ -0,0 +1,8 @@
+// Package reference embeds the OpenAPI description of the Yamaha Extended
+// Control API that lives next to the vendor PDFs in this directory.
+package reference
+
+import _ "embed"
+
+//go:embed yamaha-extended-control-api.yaml
+var OpenAPI []byte